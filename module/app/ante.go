@@ -0,0 +1,40 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	gravityante "github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/ante"
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/keeper"
+)
+
+// NewAnteHandler returns the default sdk AnteHandler followed by the gravity decorators, which only
+// see transactions whose signatures have been verified. Fees are waived for fee exempt orchestrator
+// transactions, the quota for those is consumed by the last decorator.
+func NewAnteHandler(
+	ak ante.AccountKeeper,
+	bankKeeper authtypes.BankKeeper,
+	gravityKeeper keeper.Keeper,
+	sigGasConsumer ante.SignatureVerificationGasConsumer,
+	signModeHandler signing.SignModeHandler,
+) sdk.AnteHandler {
+	return sdk.ChainAnteDecorators(
+		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		ante.NewRejectExtensionOptionsDecorator(),
+		gravityante.NewFeeExemptionDecorator(gravityKeeper, ante.NewMempoolFeeDecorator()),
+		ante.NewValidateBasicDecorator(),
+		ante.TxTimeoutHeightDecorator{},
+		ante.NewValidateMemoDecorator(ak),
+		ante.NewConsumeGasForTxSizeDecorator(ak),
+		ante.NewRejectFeeGranterDecorator(),
+		ante.NewSetPubKeyDecorator(ak), // SetPubKeyDecorator must be called before all signature verification decorators
+		ante.NewValidateSigCountDecorator(ak),
+		gravityante.NewFeeExemptionDecorator(gravityKeeper, ante.NewDeductFeeDecorator(ak, bankKeeper)),
+		ante.NewSigGasConsumeDecorator(ak, sigGasConsumer),
+		ante.NewSigVerificationDecorator(ak, signModeHandler),
+		ante.NewIncrementSequenceDecorator(ak),
+		gravityante.NewOrchestratorMsgDecorator(gravityKeeper),
+	)
+}
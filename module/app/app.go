@@ -94,12 +94,24 @@ import (
 
 	gravityparams "github.com/althea-net/cosmos-gravity-bridge/module/app/params"
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity"
+	gravityclient "github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/client"
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/keeper"
 	gravitytypes "github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 )
 
 const appName = "app"
 
+// addGravityParamsUpgrade is the name of the upgrade plan that sets the gravity params added since the
+// last release to their defaults, reading the params panics until they are in the store
+const addGravityParamsUpgrade = "add-gravity-params"
+
+// pruneOrphanedConfirmsUpgrade is the name of the upgrade plan that prunes confirms left behind by their parents
+const pruneOrphanedConfirmsUpgrade = "prune-orphaned-confirms"
+
+// indexOrchestratorsUpgrade is the name of the upgrade plan that indexes the orchestrators registered before
+// they were indexed by validator
+const indexOrchestratorsUpgrade = "index-orchestrators"
+
 var (
 	// DefaultNodeHome sets the folder where the applcation data and configuration will be stored
 	DefaultNodeHome string
@@ -120,6 +132,7 @@ var (
 			distrclient.ProposalHandler,
 			upgradeclient.ProposalHandler,
 			upgradeclient.CancelProposalHandler,
+			gravityclient.UnsuspendTokenProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -239,7 +252,7 @@ func NewGravityApp(
 		evidencetypes.StoreKey, ibctransfertypes.StoreKey, capabilitytypes.StoreKey,
 		gravitytypes.StoreKey,
 	)
-	tKeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+	tKeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, gravitytypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
 	//nolint: exhaustivestruct
@@ -340,12 +353,32 @@ func NewGravityApp(
 		scopedIBCKeeper,
 	)
 
+	app.transferKeeper = ibctransferkeeper.NewKeeper(
+		appCodec, keys[ibctransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
+		app.ibcKeeper.ChannelKeeper, &app.ibcKeeper.PortKeeper,
+		app.accountKeeper, app.bankKeeper, scopedTransferKeeper,
+	)
+
+	// the gravity keeper forwards deposits to IBC destinations with the transfer keeper
+	app.gravityKeeper = keeper.NewKeeper(
+		appCodec,
+		keys[gravitytypes.StoreKey],
+		tKeys[gravitytypes.TStoreKey],
+		app.GetSubspace(gravitytypes.ModuleName),
+		stakingKeeper,
+		app.bankKeeper,
+		app.slashingKeeper,
+		app.transferKeeper,
+		app.ibcKeeper.ChannelKeeper,
+	)
+
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
 		AddRoute(paramsproposal.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
-		AddRoute(ibchost.RouterKey, ibcclient.NewClientUpdateProposalHandler(app.ibcKeeper.ClientKeeper))
+		AddRoute(ibchost.RouterKey, ibcclient.NewClientUpdateProposalHandler(app.ibcKeeper.ClientKeeper)).
+		AddRoute(gravitytypes.RouterKey, gravity.NewGravityProposalHandler(app.gravityKeeper))
 
 	app.govKeeper = govkeeper.NewKeeper(
 		appCodec,
@@ -357,33 +390,25 @@ func NewGravityApp(
 		govRouter,
 	)
 
-	app.transferKeeper = ibctransferkeeper.NewKeeper(
-		appCodec, keys[ibctransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
-		app.ibcKeeper.ChannelKeeper, &app.ibcKeeper.PortKeeper,
-		app.accountKeeper, app.bankKeeper, scopedTransferKeeper,
-	)
 	transferModule := transfer.NewAppModule(app.transferKeeper)
 
-	ibcRouter := porttypes.NewRouter()
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferModule)
-	app.ibcKeeper.SetRouter(ibcRouter)
-
 	evidenceKeeper := evidencekeeper.NewKeeper(
 		appCodec,
 		keys[evidencetypes.StoreKey],
 		&stakingKeeper,
 		app.slashingKeeper,
 	)
+	evidenceRouter := evidencetypes.NewRouter().
+		AddRoute(gravitytypes.RouteBadEthSignatureEvidence, gravity.NewBadEthSignatureEvidenceHandler(app.gravityKeeper))
+	evidenceKeeper.SetRouter(evidenceRouter)
 	app.evidenceKeeper = *evidenceKeeper
+	app.gravityKeeper.SetEvidenceKeeper(&app.evidenceKeeper)
+	app.gravityKeeper.SetTelemetryEnabled(cast.ToBool(appOpts.Get("telemetry.enabled")))
 
-	app.gravityKeeper = keeper.NewKeeper(
-		appCodec,
-		keys[gravitytypes.StoreKey],
-		app.GetSubspace(gravitytypes.ModuleName),
-		stakingKeeper,
-		app.bankKeeper,
-		app.slashingKeeper,
-	)
+	// the gravity middleware pays deposits it forwarded over IBC to their fallback if their packets are refunded
+	ibcRouter := porttypes.NewRouter()
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, gravity.NewTransferMiddleware(transferModule, app.gravityKeeper))
+	app.ibcKeeper.SetRouter(ibcRouter)
 
 	app.stakingKeeper = *stakingKeeper.SetHooks(
 		stakingtypes.NewMultiStakingHooks(
@@ -525,15 +550,45 @@ func NewGravityApp(
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetAnteHandler(
-		ante.NewAnteHandler(
+		NewAnteHandler(
 			app.accountKeeper,
 			app.bankKeeper,
+			app.gravityKeeper,
 			ante.DefaultSigVerificationGasConsumer,
 			encodingConfig.TxConfig.SignModeHandler(),
 		),
 	)
 	app.SetEndBlocker(app.EndBlocker)
 
+	// a running chain has none of the params added since the last release
+	app.upgradeKeeper.SetUpgradeHandler(addGravityParamsUpgrade, func(ctx sdk.Context, plan upgradetypes.Plan) {
+		defaults := gravitytypes.DefaultParams()
+		gravitySubspace := app.GetSubspace(gravitytypes.ModuleName)
+		gravitySubspace.Set(ctx, gravitytypes.ParamStoreBatchTimeoutSuspensionThreshold, defaults.BatchTimeoutSuspensionThreshold)
+		gravitySubspace.Set(ctx, gravitytypes.ParamStoreMaxSlashingItemsPerBlock, defaults.MaxSlashingItemsPerBlock)
+		gravitySubspace.Set(ctx, gravitytypes.ParamStoreConfirmsWindow, defaults.ConfirmsWindow)
+		gravitySubspace.Set(ctx, gravitytypes.ParamStoreMinConfirmsPerWindow, defaults.MinConfirmsPerWindow)
+		gravitySubspace.Set(ctx, gravitytypes.ParamStoreJailForMissedConfirms, defaults.JailForMissedConfirms)
+		gravitySubspace.Set(ctx, gravitytypes.ParamStoreBadSignatureEvidenceBounty, defaults.BadSignatureEvidenceBounty)
+		gravitySubspace.Set(ctx, gravitytypes.ParamStoreKeyRegistrationGracePeriod, defaults.KeyRegistrationGracePeriod)
+		gravitySubspace.Set(ctx, gravitytypes.ParamStoreMaxBridgeValidators, defaults.MaxBridgeValidators)
+		gravitySubspace.Set(ctx, gravitytypes.ParamStoreMinBridgePower, defaults.MinBridgePower)
+		gravitySubspace.Set(ctx, gravitytypes.ParamStoreMaxValsetAge, defaults.MaxValsetAge)
+		gravitySubspace.Set(ctx, gravitytypes.ParamStoreAttestationsToKeep, defaults.AttestationsToKeep)
+		gravitySubspace.Set(ctx, gravitytypes.ParamStoreFeeExemptOrchestratorMsgs, defaults.FeeExemptOrchestratorMsgs)
+		gravitySubspace.Set(ctx, gravitytypes.ParamStoreFeeExemptMaxGas, defaults.FeeExemptMaxGas)
+	})
+
+	// confirms used to outlive their valsets, batches and logic calls, clean up the ones left in state
+	app.upgradeKeeper.SetUpgradeHandler(pruneOrphanedConfirmsUpgrade, func(ctx sdk.Context, plan upgradetypes.Plan) {
+		app.gravityKeeper.PruneOrphanedConfirms(ctx)
+	})
+
+	// orchestrators used to be indexed by orchestrator address only, index the ones registered before
+	app.upgradeKeeper.SetUpgradeHandler(indexOrchestratorsUpgrade, func(ctx sdk.Context, plan upgradetypes.Plan) {
+		app.gravityKeeper.IndexOrchestratorValidators(ctx)
+	})
+
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(err.Error())
@@ -0,0 +1,46 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	gravitytypes "github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+func TestAddGravityParamsUpgrade(t *testing.T) {
+	app := NewGravityApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, MakeEncodingConfig(), EmptyAppOptions{})
+	ctx := app.BaseApp.NewUncachedContext(false, tmproto.Header{Height: 10})
+	app.gravityKeeper.SetParams(ctx, *gravitytypes.DefaultParams())
+	params := app.gravityKeeper.GetParams(ctx)
+
+	// a chain running the last release has none of the params added since
+	store := prefix.NewStore(ctx.KVStore(app.keys[paramstypes.StoreKey]), append([]byte(gravitytypes.DefaultParamspace), '/'))
+	for _, key := range [][]byte{
+		gravitytypes.ParamStoreBatchTimeoutSuspensionThreshold,
+		gravitytypes.ParamStoreMaxSlashingItemsPerBlock,
+		gravitytypes.ParamStoreConfirmsWindow,
+		gravitytypes.ParamStoreMinConfirmsPerWindow,
+		gravitytypes.ParamStoreJailForMissedConfirms,
+		gravitytypes.ParamStoreBadSignatureEvidenceBounty,
+		gravitytypes.ParamStoreKeyRegistrationGracePeriod,
+		gravitytypes.ParamStoreMaxBridgeValidators,
+		gravitytypes.ParamStoreMinBridgePower,
+		gravitytypes.ParamStoreMaxValsetAge,
+		gravitytypes.ParamStoreAttestationsToKeep,
+		gravitytypes.ParamStoreFeeExemptOrchestratorMsgs,
+		gravitytypes.ParamStoreFeeExemptMaxGas,
+	} {
+		store.Delete(key)
+	}
+	require.Panics(t, func() { app.gravityKeeper.GetParams(ctx) })
+
+	app.upgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: addGravityParamsUpgrade, Height: ctx.BlockHeight()})
+	require.Equal(t, params, app.gravityKeeper.GetParams(ctx))
+}
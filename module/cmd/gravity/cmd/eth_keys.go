@@ -1,22 +1,31 @@
 package cmd
 
 import (
+	"bufio"
 	"crypto/ecdsa"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/spf13/cobra"
 	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 )
 
-const flagPassphrase = "passphrase"
+const flagYes = "yes"
 
 // Commands registers a sub-tree of commands to interact with
 // local private key storage.
@@ -25,16 +34,24 @@ func Commands(defaultNodeHome string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "eth_keys",
 		Short: "Manage your application's ethereum keys",
-		Long: `Keyring management commands. Generated by the official Ethereum go library.
+		Long: `Ethereum key management commands. Keys are stored encrypted in an Ethereum keystore in the
+keyring directory, in the format of the official Ethereum go library, and referenced by their address.
+
+Passphrases are prompted for interactively, or read line by line from stdin when it is not a terminal.
 
-The keyring supports the following backends:
-    test        Stores keys insecurely to disk. It does not prompt for a password to be unlocked
-                and it should be use only for testing purposes.
+Ethereum keys can also be stored in the keyring, next to Cosmos keys, with 'keys add-eth' and moved there
+from this keystore with 'keys import-eth'.
 `,
 	}
 
 	cmd.AddCommand(
 		AddKeyCommand(),
+		ListKeysCommand(),
+		ShowKeyCommand(),
+		ImportKeyCommand(),
+		ExportKeyCommand(),
+		DeleteKeyCommand(),
+		SignCommand(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
@@ -53,10 +70,10 @@ func AddKeyCommand() *cobra.Command {
 		Short: "Add an encrypted private ethereum key",
 		Long: `Derive a new private key and encrypt to disk.
 `,
+		Args: cobra.NoArgs,
 		RunE: runAddCmd,
 	}
 
-	cmd.Flags().String(flagPassphrase, "default", "Password used for ethereum key generation")
 	cmd.Flags().Bool(flags.FlagDryRun, false, "Perform action, but don't add key to local keystore")
 
 	cmd.SetOut(cmd.OutOrStdout())
@@ -65,10 +82,243 @@ func AddKeyCommand() *cobra.Command {
 	return cmd
 }
 
+// ListKeysCommand defines a keys command to list the stored keys
+func ListKeysCommand() *cobra.Command {
+	//nolint: exhaustivestruct
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List all ethereum keys in the keystore",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ks, err := newEthKeyStore(cmd)
+			if err != nil {
+				return err
+			}
+			var keyOutputs []EthereumKeyOutput
+			for _, account := range ks.Accounts() {
+				keyOutputs = append(keyOutputs, newEthereumKeyOutput(account, nil))
+			}
+			return printKeys(cmd, keyOutputs)
+		},
+	}
+}
+
+// ShowKeyCommand defines a keys command to show a stored key
+func ShowKeyCommand() *cobra.Command {
+	//nolint: exhaustivestruct
+	return &cobra.Command{
+		Use:   "show [address]",
+		Short: "Show the keystore file of an ethereum key",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ks, err := newEthKeyStore(cmd)
+			if err != nil {
+				return err
+			}
+			account, err := findEthKey(ks, args[0])
+			if err != nil {
+				return err
+			}
+			return printKey(cmd, newEthereumKeyOutput(account, nil))
+		},
+	}
+}
+
+// ImportKeyCommand defines a keys command to import a key
+func ImportKeyCommand() *cobra.Command {
+	//nolint: exhaustivestruct
+	return &cobra.Command{
+		Use:   "import [file]",
+		Short: "Import an ethereum key from a JSON keystore file or a file holding a hex private key",
+		Long: `Import an ethereum key from a JSON keystore file, as written by export or any Ethereum wallet,
+or from a file holding a hex encoded private key. The key is encrypted with a new passphrase.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ks, err := newEthKeyStore(cmd)
+			if err != nil {
+				return err
+			}
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			buf := bufio.NewReader(cmd.InOrStdin())
+
+			var account accounts.Account
+			if json.Valid(bz) {
+				passphrase, err := getPassphrase("Enter passphrase to decrypt the keystore file:", buf)
+				if err != nil {
+					return err
+				}
+				newPassphrase, err := getNewPassphrase(buf)
+				if err != nil {
+					return err
+				}
+				if account, err = ks.Import(bz, passphrase, newPassphrase); err != nil {
+					return err
+				}
+			} else {
+				privateKey, err := crypto.HexToECDSA(strings.TrimPrefix(strings.TrimSpace(string(bz)), "0x"))
+				if err != nil {
+					return err
+				}
+				newPassphrase, err := getNewPassphrase(buf)
+				if err != nil {
+					return err
+				}
+				if account, err = ks.ImportECDSA(privateKey, newPassphrase); err != nil {
+					return err
+				}
+			}
+
+			return printKey(cmd, newEthereumKeyOutput(account, nil))
+		},
+	}
+}
+
+// ExportKeyCommand defines a keys command to export a key
+func ExportKeyCommand() *cobra.Command {
+	//nolint: exhaustivestruct
+	return &cobra.Command{
+		Use:   "export [address]",
+		Short: "Export an ethereum key as a JSON keystore encrypted with a new passphrase",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ks, err := newEthKeyStore(cmd)
+			if err != nil {
+				return err
+			}
+			account, err := findEthKey(ks, args[0])
+			if err != nil {
+				return err
+			}
+			buf := bufio.NewReader(cmd.InOrStdin())
+
+			passphrase, err := getPassphrase("Enter passphrase to decrypt your key:", buf)
+			if err != nil {
+				return err
+			}
+			exportPassphrase, err := input.GetPassword("Enter passphrase to encrypt the exported key:", buf)
+			if err != nil {
+				return err
+			}
+			keyJSON, err := ks.Export(account, passphrase, exportPassphrase)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), string(keyJSON))
+			return nil
+		},
+	}
+}
+
+// DeleteKeyCommand defines a keys command to delete a key
+func DeleteKeyCommand() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "delete [address]",
+		Short: "Delete an ethereum key from the keystore",
+		Long: `Delete an ethereum key from the keystore. The key can not be recovered unless it was exported
+before, its passphrase is required to delete it.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ks, err := newEthKeyStore(cmd)
+			if err != nil {
+				return err
+			}
+			account, err := findEthKey(ks, args[0])
+			if err != nil {
+				return err
+			}
+			buf := bufio.NewReader(cmd.InOrStdin())
+
+			if skip, _ := cmd.Flags().GetBool(flagYes); !skip {
+				if yes, err := input.GetConfirmation("Key will be deleted. Continue?", buf, cmd.ErrOrStderr()); err != nil {
+					return err
+				} else if !yes {
+					return nil
+				}
+			}
+			passphrase, err := getPassphrase("Enter passphrase to decrypt your key:", buf)
+			if err != nil {
+				return err
+			}
+			if err := ks.Delete(account, passphrase); err != nil {
+				return err
+			}
+
+			cmd.PrintErrln("Key deleted forever (uh oh!)")
+			return nil
+		},
+	}
+	cmd.Flags().BoolP(flagYes, "y", false, "Skip confirmation prompt when deleting the key")
+	return cmd
+}
+
+// SignCommand defines a keys command to sign a checkpoint
+func SignCommand() *cobra.Command {
+	//nolint: exhaustivestruct
+	return &cobra.Command{
+		Use:   "sign [address] [hex-hash]",
+		Short: "Sign a 32 byte hash, such as a valset, batch or logic call checkpoint, with an ethereum key",
+		Long: `Sign a 32 byte hash with an ethereum key. The hash is prefixed with the Ethereum signed message
+prefix before signing, exactly as the orchestrator signs checkpoints, and the hex encoded signature is printed.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ks, err := newEthKeyStore(cmd)
+			if err != nil {
+				return err
+			}
+			account, err := findEthKey(ks, args[0])
+			if err != nil {
+				return err
+			}
+			hash, err := hex.DecodeString(strings.TrimPrefix(args[1], "0x"))
+			if err != nil {
+				return err
+			}
+			if len(hash) != 32 {
+				return fmt.Errorf("expected a 32 byte hash, got %d bytes", len(hash))
+			}
+
+			passphrase, err := getPassphrase("Enter passphrase to decrypt your key:", bufio.NewReader(cmd.InOrStdin()))
+			if err != nil {
+				return err
+			}
+			key, err := decryptEthKey(account, passphrase)
+			if err != nil {
+				return err
+			}
+			signature, err := types.NewEthereumSignature(hash, key.PrivateKey)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), hex.EncodeToString(signature))
+			return nil
+		},
+	}
+}
+
 type EthereumKeyOutput struct {
-	PublicKey  string `json:"public_key"`
-	PrivateKey string `json:"private_key"`
-	Address    string `json:"address"`
+	PublicKey string `json:"public_key,omitempty"`
+	Address   string `json:"address"`
+	File      string `json:"file,omitempty"`
+}
+
+func newEthereumKeyOutput(account accounts.Account, publicKey *ecdsa.PublicKey) EthereumKeyOutput {
+	keyOutput := EthereumKeyOutput{
+		Address: account.Address.Hex(),
+		File:    account.URL.Path,
+	}
+	if publicKey != nil {
+		keyOutput.PublicKey = hexutil.Encode(crypto.FromECDSAPub(publicKey))
+	}
+	return keyOutput
 }
 
 func runAddCmd(cmd *cobra.Command, args []string) error {
@@ -76,53 +326,127 @@ func runAddCmd(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
-	privateKeyBytes := crypto.FromECDSA(privateKey)
 
 	publicKey := privateKey.Public()
 	publicKeyECDSA, ok := publicKey.(*ecdsa.PublicKey)
 	if !ok {
 		return errors.New("error casting public key to ECDSA")
 	}
-	publicKeyBytes := crypto.FromECDSAPub(publicKeyECDSA)
-
-	keyOutput := EthereumKeyOutput{
-		PrivateKey: hexutil.Encode(privateKeyBytes),
-		PublicKey:  hexutil.Encode(publicKeyBytes),
-		Address:    crypto.PubkeyToAddress(*publicKeyECDSA).Hex(),
-	}
 
+	account := accounts.Account{Address: crypto.PubkeyToAddress(*publicKeyECDSA)}
 	if dryRun, _ := cmd.Flags().GetBool(flags.FlagDryRun); !dryRun {
-		clientCtx, err := client.GetClientQueryContext(cmd)
+		ks, err := newEthKeyStore(cmd)
 		if err != nil {
 			return err
 		}
-		ks := keystore.NewKeyStore(clientCtx.KeyringDir, keystore.StandardScryptN, keystore.StandardScryptP)
-		passphrase, err := cmd.Flags().GetString(flagPassphrase)
+		passphrase, err := getNewPassphrase(bufio.NewReader(cmd.InOrStdin()))
 		if err != nil {
 			return err
 		}
-		if _, err := ks.ImportECDSA(privateKey, passphrase); err != nil {
+		if account, err = ks.ImportECDSA(privateKey, passphrase); err != nil {
 			return err
 		}
 	}
 
-	return printCreate(cmd, keyOutput)
+	return printKey(cmd, newEthereumKeyOutput(account, publicKeyECDSA))
+}
+
+// newEthKeyStore opens the ethereum keystore in the keyring directory
+func newEthKeyStore(cmd *cobra.Command) (*keystore.KeyStore, error) {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return nil, err
+	}
+	return keystore.NewKeyStore(clientCtx.KeyringDir, keystore.StandardScryptN, keystore.StandardScryptP), nil
+}
+
+// findEthKey finds the key of an ethereum address in the keystore
+func findEthKey(ks *keystore.KeyStore, address string) (accounts.Account, error) {
+	if !common.IsHexAddress(address) {
+		return accounts.Account{}, fmt.Errorf("invalid ethereum address %s", address)
+	}
+	return ks.Find(accounts.Account{Address: common.HexToAddress(address)})
+}
+
+// decryptEthKey reads and decrypts the keystore file of an account
+func decryptEthKey(account accounts.Account, passphrase string) (*keystore.Key, error) {
+	keyJSON, err := ioutil.ReadFile(account.URL.Path)
+	if err != nil {
+		return nil, err
+	}
+	return keystore.DecryptKey(keyJSON, passphrase)
+}
+
+// getPassphrase reads the passphrase of an existing key, unlike input.GetPassword it accepts
+// passphrases shorter than the minimum length that keys may have been encrypted with before
+func getPassphrase(prompt string, buf *bufio.Reader) (string, error) {
+	passphrase, err := input.GetPassword(prompt, buf)
+	if err != nil && passphrase == "" {
+		return "", err
+	}
+	return passphrase, nil
+}
+
+// getNewPassphrase reads a new passphrase and asks to repeat it
+func getNewPassphrase(buf *bufio.Reader) (string, error) {
+	passphrase, err := input.GetPassword("Enter passphrase to encrypt your key:", buf)
+	if err != nil {
+		return "", err
+	}
+	repeated, err := input.GetPassword("Repeat the passphrase:", buf)
+	if err != nil {
+		return "", err
+	}
+	if passphrase != repeated {
+		return "", errors.New("passphrases don't match")
+	}
+	return passphrase, nil
 }
 
-func printCreate(cmd *cobra.Command, keyOutput EthereumKeyOutput) error {
+// printKey and printKeys write to stdout, cobra's Print functions write to stderr unless an output is set
+func printKey(cmd *cobra.Command, keyOutput EthereumKeyOutput) error {
 	output, _ := cmd.Flags().GetString(cli.OutputFlag)
 
 	switch output {
 	case keys.OutputFormatText:
 		cmd.PrintErrln()
-		cmd.Printf("private: %s \npublic: %s \naddress: %s\n", keyOutput.PrivateKey, keyOutput.PublicKey, keyOutput.Address)
+		if keyOutput.PublicKey != "" {
+			fmt.Fprintf(cmd.OutOrStdout(), "public: %s \n", keyOutput.PublicKey)
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "address: %s \n", keyOutput.Address)
+		if keyOutput.File != "" {
+			fmt.Fprintf(cmd.OutOrStdout(), "file: %s\n", keyOutput.File)
+		}
 
 	case keys.OutputFormatJSON:
 		outputBytes, err := json.Marshal(keyOutput)
 		if err != nil {
 			return err
 		}
-		cmd.Println(string(outputBytes))
+		fmt.Fprintln(cmd.OutOrStdout(), string(outputBytes))
+
+	default:
+		return fmt.Errorf("invalid output format %s", output)
+	}
+
+	return nil
+}
+
+func printKeys(cmd *cobra.Command, keyOutputs []EthereumKeyOutput) error {
+	output, _ := cmd.Flags().GetString(cli.OutputFlag)
+
+	switch output {
+	case keys.OutputFormatText:
+		for _, keyOutput := range keyOutputs {
+			fmt.Fprintf(cmd.OutOrStdout(), "%s %s\n", keyOutput.Address, keyOutput.File)
+		}
+
+	case keys.OutputFormatJSON:
+		outputBytes, err := json.Marshal(keyOutputs)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), string(outputBytes))
 
 	default:
 		return fmt.Errorf("invalid output format %s", output)
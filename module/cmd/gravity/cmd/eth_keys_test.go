@@ -0,0 +1,106 @@
+package cmd_test
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	gravitycmd "github.com/althea-net/cosmos-gravity-bridge/module/cmd/gravity/cmd"
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// runEthKeysCmd runs an eth_keys subcommand against the given home, feeding it stdin and returning stdout
+func runEthKeysCmd(t *testing.T, home string, stdin string, args ...string) (string, error) {
+	return runKeysCmd(t, gravitycmd.Commands, home, stdin, args...)
+}
+
+// runKeysCmd runs a subcommand of the command tree returned by newCmd against the given home, feeding it
+// stdin and returning what it writes to the process stdout. The output of the command is left unset as it
+// is in the gravity binary.
+func runKeysCmd(t *testing.T, newCmd func(string) *cobra.Command, home string, stdin string, args ...string) (string, error) {
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+	out := make(chan string)
+	go func() {
+		bz, _ := ioutil.ReadAll(r)
+		out <- string(bz)
+	}()
+
+	cmd := newCmd(home)
+	cmd.SetIn(strings.NewReader(stdin))
+	cmd.SetErr(ioutil.Discard)
+	cmd.SetArgs(append(args, fmt.Sprintf("--%s=%s", flags.FlagHome, home)))
+
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &client.Context{})
+	err = cmd.ExecuteContext(ctx)
+	require.NoError(t, w.Close())
+	return <-out, err
+}
+
+func TestEthKeysCommands(t *testing.T) {
+	home := t.TempDir()
+
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(privateKey.PublicKey).Hex()
+	keyFile := filepath.Join(t.TempDir(), "key.hex")
+	require.NoError(t, ioutil.WriteFile(keyFile, []byte(hex.EncodeToString(crypto.FromECDSA(privateKey))+"\n"), 0600))
+
+	// mismatching passphrases are rejected
+	_, err = runEthKeysCmd(t, home, "passphrase1\npassphrase2\n", "import", keyFile)
+	require.Error(t, err)
+
+	_, err = runEthKeysCmd(t, home, "passphrase1\npassphrase1\n", "import", keyFile)
+	require.NoError(t, err)
+
+	out, err := runEthKeysCmd(t, home, "", "list", fmt.Sprintf("--%s=json", cli.OutputFlag))
+	require.NoError(t, err)
+	var keys []gravitycmd.EthereumKeyOutput
+	require.NoError(t, json.Unmarshal([]byte(out), &keys))
+	require.Len(t, keys, 1)
+	require.Equal(t, address, keys[0].Address)
+
+	// the signature matches the one the orchestrator would make for the checkpoint
+	hash := crypto.Keccak256([]byte("checkpoint"))
+	_, err = runEthKeysCmd(t, home, "wrong passphrase\n", "sign", address, hex.EncodeToString(hash))
+	require.Error(t, err)
+	out, err = runEthKeysCmd(t, home, "passphrase1\n", "sign", address, hex.EncodeToString(hash))
+	require.NoError(t, err)
+	expected, err := types.NewEthereumSignature(hash, privateKey)
+	require.NoError(t, err)
+	require.Equal(t, hex.EncodeToString(expected), strings.TrimSpace(out))
+	require.NoError(t, types.ValidateEthereumSignature(hash, expected, address))
+
+	out, err = runEthKeysCmd(t, home, "passphrase1\npassphrase2\n", "export", address)
+	require.NoError(t, err)
+	exportFile := filepath.Join(t.TempDir(), "key.json")
+	require.NoError(t, ioutil.WriteFile(exportFile, []byte(out), 0600))
+
+	_, err = runEthKeysCmd(t, home, "passphrase1\n", "delete", address, "--yes")
+	require.NoError(t, err)
+	_, err = runEthKeysCmd(t, home, "", "show", address)
+	require.Error(t, err)
+
+	// the exported keystore can be imported back
+	_, err = runEthKeysCmd(t, home, "passphrase2\npassphrase3\npassphrase3\n", "import", exportFile)
+	require.NoError(t, err)
+	out, err = runEthKeysCmd(t, home, "", "show", address)
+	require.NoError(t, err)
+	require.Contains(t, out, address)
+}
@@ -44,13 +44,14 @@ func GenTxCmd(mbm module.BasicManager, txEncCfg client.TxEncodingConfig, genBalI
 
 	//nolint: exhaustivestruct
 	cmd := &cobra.Command{
-		Use:   "gentx [key_name] [amount] [eth-address] [orchestrator-address]",
+		Use:   "gentx [key_name] [amount] [eth-address-or-key-name] [orchestrator-address]",
 		Short: "Generate a genesis tx carrying a self delegation, oracle key delegation and orchestrator key delegation",
 		Args:  cobra.ExactArgs(4),
 		Long: fmt.Sprintf(`Generate a genesis transaction that creates a validator with a self-delegation, oracle key 
 delegation and orchestrator key delegation that is signed by the key in the Keyring referenced by a given name. A node 
 ID and Bech32 consensus pubkey may optionally be provided. If they are omitted, they will be retrieved from the 
-priv_validator.json file. The following default parameters are included:
+priv_validator.json file. The Ethereum address may also be given as the name of an Ethereum key in the Keyring.
+The following default parameters are included:
     %s
 
 Example:
@@ -115,9 +116,8 @@ $ %s gentx my-key-name 1000000stake 0x033030FEeBd93E3178487c35A9c8cA80874353C9 c
 				return errors.Wrapf(err, "failed to fetch '%s' from the keyring", name)
 			}
 
-			ethAddress := args[2]
-
-			if err := gravitytypes.ValidateEthAddress(ethAddress); err != nil {
+			ethAddress, err := gravitytypes.ResolveEthAddress(clientCtx.Keyring, args[2])
+			if err != nil {
 				return errors.Wrapf(err, "invalid ethereum address")
 			}
 
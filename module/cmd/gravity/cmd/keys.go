@@ -0,0 +1,207 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	sdkcrypto "github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	gravitytypes "github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+const (
+	flagRecover = "recover"
+	flagHDPath  = "hd-path"
+)
+
+// KeysCommands extends the keys commands of the SDK with commands adding Ethereum keys
+// to the keyring, which can then be listed, exported, imported and deleted like any key
+func KeysCommands(defaultNodeHome string) *cobra.Command {
+	cmd := keys.Commands(defaultNodeHome)
+	cmd.AddCommand(
+		AddEthKeyCommand(),
+		ImportEthKeyCommand(),
+	)
+	return cmd
+}
+
+// AddEthKeyCommand defines a keys command to add an Ethereum key to the keyring
+func AddEthKeyCommand() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "add-eth [name]",
+		Short: "Add an Ethereum key to the keyring, from a new or recovered mnemonic",
+		Long: fmt.Sprintf(`Derive an Ethereum key from a new mnemonic, or a recovered one with --recover, and store it in
+the keyring under the given name. Ethereum keys have the %s algorithm, their address is derived the Ethereum
+way and they sign with the Ethereum signed message prefix, so they can be used to sign valset, batch and logic
+call confirmations with the --eth-key flag and given by name instead of by address to gentx and
+set-orchestrator-address.
+`, gravitytypes.EthSecp256k1Type),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			buf := bufio.NewReader(cmd.InOrStdin())
+			kr, err := newEthKeyring(cmd, buf)
+			if err != nil {
+				return err
+			}
+			name := args[0]
+			if _, err := kr.Key(name); err == nil {
+				return fmt.Errorf("key %s already exists", name)
+			}
+			hdPath, err := cmd.Flags().GetString(flagHDPath)
+			if err != nil {
+				return err
+			}
+
+			var (
+				info     keyring.Info
+				mnemonic string
+			)
+			if recoverKey, _ := cmd.Flags().GetBool(flagRecover); recoverKey {
+				mnemonic, err = input.GetString("Enter your bip39 mnemonic", buf)
+				if err != nil {
+					return err
+				}
+				if info, err = kr.NewAccount(name, mnemonic, "", hdPath, gravitytypes.EthSecp256k1); err != nil {
+					return err
+				}
+				// the mnemonic was given by the user, there is no need to show it again
+				mnemonic = ""
+			} else {
+				if info, mnemonic, err = kr.NewMnemonic(name, keyring.English, hdPath, gravitytypes.EthSecp256k1); err != nil {
+					return err
+				}
+			}
+
+			return printEthKey(cmd, info, mnemonic)
+		},
+	}
+	cmd.Flags().Bool(flagRecover, false, "Provide a seed phrase to recover an existing key instead of creating a new one")
+	cmd.Flags().String(flagHDPath, gravitytypes.EthereumHDPath, "The HD path to derive the key with")
+	return cmd
+}
+
+// ImportEthKeyCommand defines a keys command to import an Ethereum key into the keyring
+func ImportEthKeyCommand() *cobra.Command {
+	//nolint: exhaustivestruct
+	return &cobra.Command{
+		Use:   "import-eth [name] [file]",
+		Short: "Import an Ethereum key into the keyring from a JSON keystore file or a file holding a hex private key",
+		Long: `Import an Ethereum key into the keyring from a JSON keystore file, such as the ones the eth_keys
+commands store their keys in, or from a file holding a hex encoded private key.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			buf := bufio.NewReader(cmd.InOrStdin())
+			kr, err := newEthKeyring(cmd, buf)
+			if err != nil {
+				return err
+			}
+			name := args[0]
+			bz, err := ioutil.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			var privateKey []byte
+			if json.Valid(bz) {
+				passphrase, err := getPassphrase("Enter passphrase to decrypt the keystore file:", buf)
+				if err != nil {
+					return err
+				}
+				key, err := keystore.DecryptKey(bz, passphrase)
+				if err != nil {
+					return err
+				}
+				privateKey = crypto.FromECDSA(key.PrivateKey)
+			} else {
+				key, err := crypto.HexToECDSA(strings.TrimPrefix(strings.TrimSpace(string(bz)), "0x"))
+				if err != nil {
+					return err
+				}
+				privateKey = crypto.FromECDSA(key)
+			}
+
+			// the keyring only imports armored keys, the armor passphrase never leaves this command
+			const armorPassphrase = "import-eth"
+			armor := sdkcrypto.EncryptArmorPrivKey(&gravitytypes.EthereumPrivKey{Key: privateKey}, armorPassphrase, string(gravitytypes.EthSecp256k1Type))
+			if err := kr.ImportPrivKey(name, armor, armorPassphrase); err != nil {
+				return err
+			}
+			info, err := kr.Key(name)
+			if err != nil {
+				return err
+			}
+
+			return printEthKey(cmd, info, "")
+		},
+	}
+}
+
+// newEthKeyring opens the keyring of the --keyring-backend flag with Ethereum keys as a supported algorithm
+func newEthKeyring(cmd *cobra.Command, buf *bufio.Reader) (keyring.Keyring, error) {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return nil, err
+	}
+	backend, err := cmd.Flags().GetString(flags.FlagKeyringBackend)
+	if err != nil {
+		return nil, err
+	}
+	return keyring.New(sdk.KeyringServiceName(), backend, clientCtx.KeyringDir, buf, gravitytypes.EthSecp256k1Option())
+}
+
+type EthereumKeyringOutput struct {
+	Name       string `json:"name"`
+	EthAddress string `json:"eth_address"`
+	Mnemonic   string `json:"mnemonic,omitempty"`
+}
+
+func printEthKey(cmd *cobra.Command, info keyring.Info, mnemonic string) error {
+	ethAddress, err := gravitytypes.EthAddressFromKey(info)
+	if err != nil {
+		return err
+	}
+	keyOutput := EthereumKeyringOutput{
+		Name:       info.GetName(),
+		EthAddress: ethAddress,
+		Mnemonic:   mnemonic,
+	}
+
+	output, _ := cmd.Flags().GetString(cli.OutputFlag)
+	switch output {
+	case keys.OutputFormatText:
+		fmt.Fprintf(cmd.OutOrStdout(), "- name: %s\n  eth_address: %s\n", keyOutput.Name, keyOutput.EthAddress)
+		if mnemonic != "" {
+			cmd.PrintErrln("\n**Important** write this mnemonic phrase in a safe place.")
+			cmd.PrintErrln("It is the only way to recover your account if you ever forget your password.")
+			cmd.PrintErrln()
+			cmd.PrintErrln(mnemonic)
+		}
+
+	case keys.OutputFormatJSON:
+		outputBytes, err := json.Marshal(keyOutput)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), string(outputBytes))
+
+	default:
+		return fmt.Errorf("invalid output format %s", output)
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package cmd_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	gravitycmd "github.com/althea-net/cosmos-gravity-bridge/module/cmd/gravity/cmd"
+)
+
+func TestAddEthKeyCommand(t *testing.T) {
+	const (
+		// the development mnemonic of hardhat and many other Ethereum tools
+		mnemonic   = "test test test test test test test test test test test junk"
+		ethAddress = "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"
+	)
+	home := t.TempDir()
+	backend := fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest)
+
+	out, err := runKeysCmd(t, gravitycmd.KeysCommands, home, mnemonic+"\n", "add-eth", "eth", "--recover", backend, fmt.Sprintf("--%s=json", cli.OutputFlag))
+	require.NoError(t, err)
+	var key gravitycmd.EthereumKeyringOutput
+	require.NoError(t, json.Unmarshal([]byte(out), &key))
+	require.Equal(t, "eth", key.Name)
+	require.Equal(t, ethAddress, key.EthAddress)
+
+	out, err = runKeysCmd(t, gravitycmd.KeysCommands, home, "", "add-eth", "eth2", backend)
+	require.NoError(t, err)
+	require.Contains(t, out, "name: eth2")
+}
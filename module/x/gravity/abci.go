@@ -2,22 +2,31 @@ package gravity
 
 import (
 	"sort"
+	"time"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/keeper"
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+	metrics "github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // EndBlocker is called at the end of every block
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
 	params := k.GetParams(ctx)
+	k.MeasureCosmosBlockTime(ctx)
 	slashing(ctx, k)
+	k.JailValidatorsWithoutKeys(ctx, params)
 	attestationTally(ctx, k)
 	cleanupTimedOutBatches(ctx, k)
 	cleanupTimedOutLogicCalls(ctx, k)
 	createValsets(ctx, k)
 	pruneValsets(ctx, k, params)
-	pruneAttestations(ctx, k)
+	k.PruneConfirms(ctx)
+	pruneAttestations(ctx, k, params)
+	k.SetBridgeGauges(ctx)
 }
 
 func createValsets(ctx sdk.Context, k keeper.Keeper) {
@@ -28,12 +37,18 @@ func createValsets(ctx sdk.Context, k keeper.Keeper) {
 	//      This will make sure the unbonding validator has to provide an attestation to a new Valset
 	//	    that excludes him before he completely Unbonds.  Otherwise he will be slashed
 	// 3. If power change between validators of CurrentValset and latest valset request is > 5%
+	// 4. If a bonded validator registered delegate keys in the current block, it joins the valset with whatever power it has
+	// 5. If the latest valset request is older than MaxValsetAge blocks, so small power changes can't accumulate forever
 
 	// get the last valsets to compare against
 	latestValset := k.GetLatestValset(ctx)
 	lastUnbondingHeight := k.GetLastUnBondingBlockHeight(ctx)
+	lastKeyRegistrationHeight := k.GetLastKeyRegistrationBlockHeight(ctx)
+	maxValsetAge := k.GetParams(ctx).MaxValsetAge
 
-	if (latestValset == nil) || (lastUnbondingHeight == uint64(ctx.BlockHeight())) || (types.BridgeValidators(k.GetCurrentValset(ctx).Members).PowerDiff(latestValset.Members) > 0.05) {
+	if (latestValset == nil) || (lastUnbondingHeight == uint64(ctx.BlockHeight())) || (lastKeyRegistrationHeight == uint64(ctx.BlockHeight())) ||
+		(maxValsetAge != 0 && uint64(ctx.BlockHeight()) >= latestValset.Height+maxValsetAge) ||
+		(types.BridgeValidators(k.GetCurrentValset(ctx).Members).PowerDiff(latestValset.Members) > 0.05) {
 		// if the conditions are true, put in a new validator set request to be signed and submitted to Ethereum
 		k.SetValsetRequest(ctx)
 	}
@@ -46,20 +61,41 @@ func pruneValsets(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 	//
 	// Only prune valsets after the signed valsets window has passed
 	// so that slashing can occur the block before we remove them
+	//
+	// Only the members of the valset a batch or logic call was created under are expected to confirm it, so the
+	// valset covering the oldest batch or logic call that has not been checked for missed confirms is kept too
 	lastObserved := k.GetLastObservedValset(ctx)
 	currentBlock := uint64(ctx.BlockHeight())
 	tooEarly := currentBlock < params.SignedValsetsWindow
 	if lastObserved != nil && !tooEarly {
 		earliestToPrune := currentBlock - params.SignedValsetsWindow
+		keepFrom := oldestUnslashedItemValset(ctx, k)
 		sets := k.GetValsets(ctx)
 		for _, set := range sets {
-			if set.Nonce < lastObserved.Nonce && set.Height < earliestToPrune {
+			if set.Nonce < lastObserved.Nonce && set.Height < earliestToPrune && (keepFrom == nil || set.Nonce < keepFrom.Nonce) {
 				k.DeleteValset(ctx, set.Nonce)
 			}
 		}
 	}
 }
 
+// oldestUnslashedItemValset returns the valset the oldest batch or logic call that has not been checked for
+// missed confirms yet was created under, or nil if there is no such batch, logic call or valset
+func oldestUnslashedItemValset(ctx sdk.Context, k keeper.Keeper) *types.Valset {
+	nextBlock := uint64(ctx.BlockHeight()) + 1
+	var oldest uint64
+	if batches := k.GetUnSlashedBatches(ctx, nextBlock, 1); len(batches) > 0 {
+		oldest = batches[0].Block
+	}
+	if calls := k.GetUnSlashedLogicCalls(ctx, nextBlock, 1); len(calls) > 0 && (oldest == 0 || calls[0].Block < oldest) {
+		oldest = calls[0].Block
+	}
+	if oldest == 0 {
+		return nil
+	}
+	return k.GetValsetAtHeight(ctx, oldest)
+}
+
 func slashing(ctx sdk.Context, k keeper.Keeper) {
 
 	params := k.GetParams(ctx)
@@ -84,6 +120,9 @@ func attestationTally(ctx sdk.Context, k keeper.Keeper) {
 	// Then we sort it
 	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
 
+	// Applying attestations doesn't change the bridge validator set, so it is found once for all of them
+	threshold := k.GetAttestationThreshold(ctx)
+
 	// This iterates over all keys (event nonces) in the attestation mapping. Each value contains
 	// a slice with one or more attestations at that event nonce. There can be multiple attestations
 	// at one event nonce when validators disagree about what event happened at that nonce.
@@ -109,10 +148,24 @@ func attestationTally(ctx sdk.Context, k keeper.Keeper) {
 			// If no attestation becomes observed, when we get to the next nonce, every attestation in
 			// it will be skipped. The same will happen for every nonce after that.
 			if nonce == uint64(k.GetLastObservedEventNonce(ctx))+1 {
-				k.TryAttestation(ctx, &att)
+				k.TryAttestation(ctx, &att, threshold)
 			}
 		}
 	}
+
+	// Ethereum height claims have their own nonce space and are not events, so there is no need to observe
+	// them in order. Pending attestations are sorted by height nonce, once one is observed every attestation
+	// at or below its nonce is skipped.
+	for _, att := range k.GetPendingEthereumHeightAttestations(ctx) {
+		att := att
+		claim, err := k.UnpackAttestationClaim(&att)
+		if err != nil {
+			panic("couldn't cast to claim")
+		}
+		if claim.GetEventNonce() > k.GetLastObservedEthereumHeightNonce(ctx) {
+			k.TryAttestation(ctx, &att, threshold)
+		}
+	}
 }
 
 // cleanupTimedOutBatches deletes batches that have passed their expiration on Ethereum
@@ -121,15 +174,21 @@ func attestationTally(ctx sdk.Context, k keeper.Keeper) {
 //    this means that we MUST only cleanup a single batch at a time
 // B) it is possible for ethereumHeight to be zero if no events have ever occurred, make sure your code accounts for this
 // C) When we compute the timeout we do our best to estimate the Ethereum block height at that very second. But what we work with
-//    here is the Ethereum block height at the time of the last Deposit or Withdraw to be observed. It's very important we do not
+//    here is the Ethereum block height at the time of the last Deposit, Withdraw or height claim to be observed. It's very important we do not
 //    project, if we do a slowdown on ethereum could cause a double spend. Instead timeouts will *only* occur after the timeout period
-//    AND any deposit or withdraw has occurred to update the Ethereum block height.
+//    AND any deposit, withdraw or Ethereum height claim has occurred to update the Ethereum block height.
 func cleanupTimedOutBatches(ctx sdk.Context, k keeper.Keeper) {
 	ethereumHeight := k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight
 	batches := k.GetOutgoingTxBatches(ctx)
 	for _, batch := range batches {
 		if batch.BatchTimeout < ethereumHeight {
 			k.CancelOutgoingTXBatch(ctx, batch.TokenContract, batch.BatchNonce)
+			k.RecordBatchTimeout(ctx, batch.TokenContract)
+			telemetry.IncrCounterWithLabels(
+				[]string{types.ModuleName, types.MetricKeyBatchTimeouts},
+				1,
+				[]metrics.Label{telemetry.NewLabel(types.MetricLabelTokenContract, batch.TokenContract)},
+			)
 		}
 	}
 }
@@ -140,9 +199,9 @@ func cleanupTimedOutBatches(ctx sdk.Context, k keeper.Keeper) {
 //    this means that we MUST only cleanup a single call at a time
 // B) it is possible for ethereumHeight to be zero if no events have ever occurred, make sure your code accounts for this
 // C) When we compute the timeout we do our best to estimate the Ethereum block height at that very second. But what we work with
-//    here is the Ethereum block height at the time of the last Deposit or Withdraw to be observed. It's very important we do not
+//    here is the Ethereum block height at the time of the last Deposit, Withdraw or height claim to be observed. It's very important we do not
 //    project, if we do a slowdown on ethereum could cause a double spend. Instead timeouts will *only* occur after the timeout period
-//    AND any deposit or withdraw has occurred to update the Ethereum block height.
+//    AND any deposit, withdraw or Ethereum height claim has occurred to update the Ethereum block height.
 func cleanupTimedOutLogicCalls(ctx sdk.Context, k keeper.Keeper) {
 	ethereumHeight := k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight
 	calls := k.GetOutgoingLogicCalls(ctx)
@@ -153,103 +212,251 @@ func cleanupTimedOutLogicCalls(ctx sdk.Context, k keeper.Keeper) {
 	}
 }
 
+// slashingCandidate caches what slashing needs to know about a validator, it is looked up once per block
+// rather than once for every valset, batch or logic call checked
+type slashingCandidate struct {
+	operator       sdk.ValAddress
+	consAddr       sdk.ConsAddress
+	hasSigningInfo bool
+	startHeight    int64
+	ethAddress     string
+	// unbonding and unbondingHeight are only set for unbonding validators
+	unbonding       bool
+	unbondingHeight int64
+	// jailed is set once a bonded validator is jailed in this block, it has then left the bonded set
+	jailed bool
+	// awaitingKeys is set for validators that have bonded but not yet registered delegate keys
+	awaitingKeys bool
+}
+
+// bondedSlashingCandidates returns the current bonded set ready to be checked against confirms
+func bondedSlashingCandidates(ctx sdk.Context, k keeper.Keeper) []*slashingCandidate {
+	bonded := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	out := make([]*slashingCandidate, 0, len(bonded))
+	for _, val := range bonded {
+		operator := val.GetOperator()
+		consAddr, _ := val.GetConsAddr()
+		valSigningInfo, exist := k.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+		// problem site for delegate key rotation, see issue #344
+		ethAddress, hasKeys := k.GetEthAddressByValidator(ctx, operator)
+		_, awaiting := k.GetValidatorAwaitingKeys(ctx, operator)
+		out = append(out, &slashingCandidate{
+			operator:        operator,
+			consAddr:        consAddr,
+			hasSigningInfo:  exist,
+			startHeight:     valSigningInfo.StartHeight,
+			ethAddress:      ethAddress,
+			unbonding:       false,
+			unbondingHeight: 0,
+			jailed:          false,
+			awaitingKeys:    awaiting && !hasKeys,
+		})
+	}
+	return out
+}
+
+// unbondingSlashingCandidates returns the validators that are currently unbonding
+func unbondingSlashingCandidates(ctx sdk.Context, k keeper.Keeper) []*slashingCandidate {
+	var out []*slashingCandidate
+	blockTime := ctx.BlockTime().Add(k.StakingKeeper.GetParams(ctx).UnbondingTime)
+	blockHeight := ctx.BlockHeight()
+	unbondingValIterator := k.StakingKeeper.ValidatorQueueIterator(ctx, blockTime, blockHeight)
+	defer unbondingValIterator.Close()
+
+	// All unbonding validators
+	for ; unbondingValIterator.Valid(); unbondingValIterator.Next() {
+		unbondingValidators := k.DeserializeValidatorIterator(unbondingValIterator.Value())
+
+		for _, valAddr := range unbondingValidators.Addresses {
+			addr, err := sdk.ValAddressFromBech32(valAddr)
+			if err != nil {
+				panic(err)
+			}
+			validator, _ := k.StakingKeeper.GetValidator(ctx, addr)
+			if !validator.IsUnbonding() {
+				continue
+			}
+			valConsAddr, _ := validator.GetConsAddr()
+			valSigningInfo, exist := k.SlashingKeeper.GetValidatorSigningInfo(ctx, valConsAddr)
+			ethAddress, _ := k.GetEthAddressByValidator(ctx, addr)
+			out = append(out, &slashingCandidate{
+				operator:        addr,
+				consAddr:        valConsAddr,
+				hasSigningInfo:  exist,
+				startHeight:     valSigningInfo.StartHeight,
+				ethAddress:      ethAddress,
+				unbonding:       true,
+				unbondingHeight: validator.UnbondingHeight,
+				jailed:          false,
+				awaitingKeys:    false,
+			})
+		}
+	}
+	return out
+}
+
+// confirmingValidators resolves the orchestrators that submitted confirms for a single valset, batch or logic
+// call into the set of validator operator addresses that signed it, keyed by the raw address bytes. This way
+// checking each validator is a map lookup instead of a scan over every confirm
+func confirmingValidators(ctx sdk.Context, k keeper.Keeper, orchestrators []string) map[string]bool {
+	signers := make(map[string]bool, len(orchestrators))
+	for _, orchestrator := range orchestrators {
+		// TODO this presents problems for delegate key rotation see issue #344
+		orchAddr, err := sdk.AccAddressFromBech32(orchestrator)
+		if err != nil {
+			continue
+		}
+		if valAddr, found := k.GetOrchestratorValidatorAddr(ctx, orchAddr); found {
+			signers[string(valAddr)] = true
+		}
+	}
+	return signers
+}
+
+// confirmLiveness records for every bonded and unbonding validator whether it confirmed a single valset, batch
+// or logic call created at itemHeight, and slashes those who have now missed too many confirms of this type.
+// Validators that joined after the item was created are not expected to confirm it, unbonding validators are
+// expected to confirm items created up to UnbondSlashingValsetsWindow blocks after they started unbonding.
+// Validators in their key registration grace period can't confirm anything yet and are skipped, as are
+// validators outside of the bridge validator set expected to sign the item when members is not nil.
+func confirmLiveness(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	params types.Params,
+	confirmType types.ConfirmType,
+	itemHeight uint64,
+	candidates []*slashingCandidate,
+	members map[string]bool,
+	confirmed func(*slashingCandidate) bool,
+	slashFraction sdk.Dec,
+) {
+	for _, val := range candidates {
+		if val.jailed || val.awaitingKeys || !val.hasSigningInfo || val.startHeight >= int64(itemHeight) {
+			continue
+		}
+		if members != nil && !members[val.ethAddress] {
+			continue
+		}
+		if val.unbonding && itemHeight >= uint64(val.unbondingHeight)+params.UnbondSlashingValsetsWindow {
+			continue
+		}
+		if k.HandleConfirmLiveness(ctx, params, confirmType, val.operator, confirmed(val)) {
+			slashAndJail(ctx, k, params, val, slashFraction, missedConfirmsReason[confirmType])
+		}
+	}
+}
+
+// bridgeMembers returns the Ethereum addresses of the members of the given valsets, these are the validators
+// expected to confirm an item. Returns nil, meaning every validator is expected to confirm, if no valset is known.
+func bridgeMembers(valsets ...*types.Valset) map[string]bool {
+	var out map[string]bool
+	for _, vs := range valsets {
+		if vs == nil {
+			continue
+		}
+		if out == nil {
+			out = make(map[string]bool, len(vs.Members))
+		}
+		for _, member := range vs.Members {
+			out[member.EthereumAddress] = true
+		}
+	}
+	return out
+}
+
+// missedConfirmsReason is the reason validators are slashed for missing each type of confirm
+var missedConfirmsReason = map[types.ConfirmType]types.SlashingReason{
+	types.CONFIRM_TYPE_VALSET:     types.SLASHING_REASON_MISSED_VALSET_CONFIRMS,
+	types.CONFIRM_TYPE_BATCH:      types.SLASHING_REASON_MISSED_BATCH_CONFIRMS,
+	types.CONFIRM_TYPE_LOGIC_CALL: types.SLASHING_REASON_MISSED_LOGIC_CALL_CONFIRMS,
+}
+
+// slashAndJail slashes a validator that missed too many confirms and, if JailForMissedConfirms is set, jails
+// them if they are not already jailed. The validator is fetched again since earlier slashing in this block may
+// have changed its power. Jailed bonded validators leave the bonded set, they are skipped just as they would be
+// by GetBondedValidatorsByPower.
+func slashAndJail(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	params types.Params,
+	candidate *slashingCandidate,
+	fraction sdk.Dec,
+	reason types.SlashingReason,
+) {
+	val, found := k.StakingKeeper.GetValidator(ctx, candidate.operator)
+	if !found || (!candidate.unbonding && val.IsJailed()) {
+		return
+	}
+	k.StakingKeeper.Slash(ctx, candidate.consAddr, ctx.BlockHeight(), val.ConsensusPower(), fraction)
+	k.EmitTypedEvent(ctx, &types.EventValidatorSlashed{
+		Validator:        candidate.operator.String(),
+		Reason:           reason,
+		SlashFraction:    fraction,
+		InfractionHeight: ctx.BlockHeight(),
+	})
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, types.MetricKeySlashes},
+		1,
+		[]metrics.Label{telemetry.NewLabel(types.MetricLabelReason, reason.String())},
+	)
+	if params.JailForMissedConfirms && !val.IsJailed() {
+		k.StakingKeeper.Jail(ctx, candidate.consAddr)
+		k.EmitTypedEvent(ctx, &types.EventValidatorJailed{Validator: candidate.operator.String(), Reason: reason})
+		candidate.jailed = !candidate.unbonding
+		// Our unbonding hook SHOULD be triggered after the above jail
+		// but is not when triggered by the endblocker TODO investigate why
+		k.SetLastUnBondingBlockHeight(ctx, uint64(ctx.BlockHeight()))
+	}
+}
+
+// ValsetSlashing checks the liveness of validators on valsets that are older than the SignedValsetsWindow, see
+// confirmLiveness. At most MaxSlashingItemsPerBlock valsets are checked per block, the LastSlashedValsetNonce
+// serves as a cursor so any remaining valsets are checked in the following blocks.
 func ValsetSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 	// don't slash in the beginning before there aren't even SignedValsetsWindow blocks yet
 	if uint64(ctx.BlockHeight()) <= params.SignedValsetsWindow {
 		return
 	}
 
-	unslashedValsets := k.GetUnSlashedValsets(ctx, params.SignedValsetsWindow)
-
 	// unslashedValsets are sorted by nonce in ASC order
-	// Question: do we need to sort each time? See if this can be epoched
+	unslashedValsets := k.GetUnSlashedValsets(ctx, params.SignedValsetsWindow, params.MaxSlashingItemsPerBlock)
+	if len(unslashedValsets) == 0 {
+		return
+	}
+
+	candidates := append(bondedSlashingCandidates(ctx, k), unbondingSlashingCandidates(ctx, k)...)
 	for _, vs := range unslashedValsets {
 		confirms := k.GetValsetConfirms(ctx, vs.Nonce)
-
-		// SLASH BONDED VALIDTORS who didn't attest valset request
-		currentBondedSet := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
-		for _, val := range currentBondedSet {
-			consAddr, _ := val.GetConsAddr()
-			valSigningInfo, exist := k.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
-
-			//  Slash validator ONLY if he joined before valset is created
-			if exist && uint64(valSigningInfo.StartHeight) < vs.Height {
-				// Check if validator has confirmed valset or not
-				found := false
-				for _, conf := range confirms {
-					// problem site for delegate key rotation, see issue #344
-					ethAddress, foundEthAddress := k.GetEthAddressByValidator(ctx, val.GetOperator())
-					if foundEthAddress && conf.EthAddress == ethAddress {
-						found = true
-						break
-					}
-				}
-				// slash validators for not confirming valsets
-				if !found {
-					cons, _ := val.GetConsAddr()
-					k.StakingKeeper.Slash(ctx, cons, ctx.BlockHeight(), val.ConsensusPower(), params.SlashFractionValset)
-					if !val.IsJailed() {
-						k.StakingKeeper.Jail(ctx, cons)
-						// Our unbonding hook SHOULD be triggered after the above jail
-						// but is not when triggered by the endblocker TODO investigate why
-						k.SetLastUnBondingBlockHeight(ctx, uint64(ctx.BlockHeight()))
-					}
-
-				}
-			}
+		ethSigners := make(map[string]bool, len(confirms))
+		orchestrators := make([]string, 0, len(confirms))
+		for _, conf := range confirms {
+			ethSigners[conf.EthAddress] = true
+			orchestrators = append(orchestrators, conf.Orchestrator)
 		}
+		signers := confirmingValidators(ctx, k, orchestrators)
 
-		// SLASH UNBONDING VALIDATORS who didn't attest valset request
-		blockTime := ctx.BlockTime().Add(k.StakingKeeper.GetParams(ctx).UnbondingTime)
-		blockHeight := ctx.BlockHeight()
-		unbondingValIterator := k.StakingKeeper.ValidatorQueueIterator(ctx, blockTime, blockHeight)
-		defer unbondingValIterator.Close()
-
-		// All unbonding validators
-		for ; unbondingValIterator.Valid(); unbondingValIterator.Next() {
-			unbondingValidators := k.DeserializeValidatorIterator(unbondingValIterator.Value())
-
-			for _, valAddr := range unbondingValidators.Addresses {
-				addr, err := sdk.ValAddressFromBech32(valAddr)
-				if err != nil {
-					panic(err)
-				}
-				validator, _ := k.StakingKeeper.GetValidator(ctx, sdk.ValAddress(addr))
-				valConsAddr, _ := validator.GetConsAddr()
-				valSigningInfo, exist := k.SlashingKeeper.GetValidatorSigningInfo(ctx, valConsAddr)
-
-				// Only slash validators who joined after valset is created and they are unbonding and UNBOND_SLASHING_WINDOW didn't passed
-				if exist && valSigningInfo.StartHeight < int64(vs.Height) && validator.IsUnbonding() && vs.Height < uint64(validator.UnbondingHeight)+params.UnbondSlashingValsetsWindow {
-					// Check if validator has confirmed valset or not
-					found := false
-					for _, conf := range confirms {
-						// TODO this presents problems for delegate key rotation see issue #344
-						confVal, _ := sdk.AccAddressFromBech32(conf.Orchestrator)
-						valAddr, foundValidator := k.GetOrchestratorValidator(ctx, confVal)
-						if foundValidator && valAddr.GetOperator().Equals(validator.GetOperator()) {
-							found = true
-							break
-						}
-					}
-
-					// slash validators for not confirming valsets
-					if !found {
-						k.StakingKeeper.Slash(ctx, valConsAddr, ctx.BlockHeight(), validator.ConsensusPower(), params.SlashFractionValset)
-						if !validator.IsJailed() {
-							k.StakingKeeper.Jail(ctx, valConsAddr)
-							// Our unbonding hook SHOULD be triggered after the above jail
-							// but is not when triggered by the endblocker TODO investigate why
-							k.SetLastUnBondingBlockHeight(ctx, uint64(ctx.BlockHeight()))
-						}
-					}
-				}
-			}
+		// both the members of the valset and of the one before it, which has to sign the update on Ethereum, are
+		// expected to confirm it
+		var previous *types.Valset
+		if vs.Nonce > 1 {
+			previous = k.GetValset(ctx, vs.Nonce-1)
 		}
+		members := bridgeMembers(vs, previous)
+
+		// a valset confirm counts if it was signed with the validators Ethereum key or submitted by its orchestrator
+		confirmLiveness(ctx, k, params, types.CONFIRM_TYPE_VALSET, vs.Height, candidates, members, func(val *slashingCandidate) bool {
+			return (val.ethAddress != "" && ethSigners[val.ethAddress]) || signers[string(val.operator)]
+		}, params.SlashFractionValset)
+
 		// then we set the latest slashed valset  nonce
 		k.SetLastSlashedValsetNonce(ctx, vs.Nonce)
 	}
 }
 
+// BatchSlashing checks the liveness of validators on batches that are older than the SignedBatchesWindow, see
+// confirmLiveness. At most MaxSlashingItemsPerBlock batches, plus any others created in the same block as the
+// last, are checked per block, the LastSlashedBatchBlock serves as a cursor so any remaining batches are
+// checked in the following blocks.
 func BatchSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 
 	// We look through the full bonded set (the active set)
@@ -264,46 +471,35 @@ func BatchSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 		return
 	}
 
-	unslashedBatches := k.GetUnSlashedBatches(ctx, maxHeight)
-	for _, batch := range unslashedBatches {
+	unslashedBatches := k.GetUnSlashedBatches(ctx, maxHeight, params.MaxSlashingItemsPerBlock)
+	if len(unslashedBatches) == 0 {
+		return
+	}
 
-		// SLASH BONDED VALIDTORS who didn't attest batch requests
-		currentBondedSet := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	candidates := append(bondedSlashingCandidates(ctx, k), unbondingSlashingCandidates(ctx, k)...)
+	for _, batch := range unslashedBatches {
 		confirms := k.GetBatchConfirmByNonceAndTokenContract(ctx, batch.BatchNonce, batch.TokenContract)
-		for _, val := range currentBondedSet {
-			// Don't slash validators who joined after batch is created
-			consAddr, _ := val.GetConsAddr()
-			valSigningInfo, exist := k.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
-			if exist && valSigningInfo.StartHeight > int64(batch.Block) {
-				continue
-			}
-
-			found := false
-			for _, conf := range confirms {
-				// TODO this presents problems for delegate key rotation see issue #344
-				confVal, _ := sdk.AccAddressFromBech32(conf.Orchestrator)
-				valAddr, foundValidator := k.GetOrchestratorValidator(ctx, confVal)
-				if foundValidator && valAddr.GetOperator().Equals(val.GetOperator()) {
-					found = true
-					break
-				}
-			}
-			if !found {
-				cons, _ := val.GetConsAddr()
-				k.StakingKeeper.Slash(ctx, cons, ctx.BlockHeight(), val.ConsensusPower(), params.SlashFractionBatch)
-				if !val.IsJailed() {
-					k.StakingKeeper.Jail(ctx, cons)
-					// Our unbonding hook SHOULD be triggered after the above jail
-					// but is not when triggered by the endblocker TODO investigate why
-					k.SetLastUnBondingBlockHeight(ctx, uint64(ctx.BlockHeight()))
-				}
-			}
+		orchestrators := make([]string, 0, len(confirms))
+		for _, conf := range confirms {
+			orchestrators = append(orchestrators, conf.Orchestrator)
 		}
+		signers := confirmingValidators(ctx, k, orchestrators)
+
+		// only the bridge validator set at the time the batch was created is expected to confirm it
+		members := bridgeMembers(k.GetValsetAtHeight(ctx, batch.Block))
+		confirmLiveness(ctx, k, params, types.CONFIRM_TYPE_BATCH, batch.Block, candidates, members, func(val *slashingCandidate) bool {
+			return signers[string(val.operator)]
+		}, params.SlashFractionBatch)
+
 		// then we set the latest slashed batch block
 		k.SetLastSlashedBatchBlock(ctx, batch.Block)
 	}
 }
 
+// LogicCallSlashing checks the liveness of validators on logic calls that are older than the
+// SignedLogicCallsWindow, see confirmLiveness. At most MaxSlashingItemsPerBlock logic calls, plus any others
+// created in the same block as the last, are checked per block, the LastSlashedLogicCallBlock serves as a
+// cursor so any remaining logic calls are checked in the following blocks.
 func LogicCallSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 
 	// We look through the full bonded set (the active set)
@@ -318,41 +514,26 @@ func LogicCallSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 		return
 	}
 
-	unslashedLogicCalls := k.GetUnSlashedLogicCalls(ctx, maxHeight)
-	for _, call := range unslashedLogicCalls {
+	unslashedLogicCalls := k.GetUnSlashedLogicCalls(ctx, maxHeight, params.MaxSlashingItemsPerBlock)
+	if len(unslashedLogicCalls) == 0 {
+		return
+	}
 
-		// SLASH BONDED VALIDTORS who didn't attest batch requests
-		currentBondedSet := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	candidates := append(bondedSlashingCandidates(ctx, k), unbondingSlashingCandidates(ctx, k)...)
+	for _, call := range unslashedLogicCalls {
 		confirms := k.GetLogicConfirmByInvalidationIDAndNonce(ctx, call.InvalidationId, call.InvalidationNonce)
-		for _, val := range currentBondedSet {
-			// Don't slash validators who joined after batch is created
-			consAddr, _ := val.GetConsAddr()
-			valSigningInfo, exist := k.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
-			if exist && valSigningInfo.StartHeight > int64(call.Block) {
-				continue
-			}
-
-			found := false
-			for _, conf := range confirms {
-				// TODO this presents problems for delegate key rotation see issue #344
-				confVal, _ := sdk.AccAddressFromBech32(conf.Orchestrator)
-				valAddr, foundValidator := k.GetOrchestratorValidator(ctx, confVal)
-				if foundValidator && valAddr.GetOperator().Equals(val.GetOperator()) {
-					found = true
-					break
-				}
-			}
-			if !found {
-				cons, _ := val.GetConsAddr()
-				k.StakingKeeper.Slash(ctx, cons, ctx.BlockHeight(), val.ConsensusPower(), params.SlashFractionLogicCall)
-				if !val.IsJailed() {
-					k.StakingKeeper.Jail(ctx, cons)
-					// Our unbonding hook SHOULD be triggered after the above jail
-					// but is not when triggered by the endblocker TODO investigate why
-					k.SetLastUnBondingBlockHeight(ctx, uint64(ctx.BlockHeight()))
-				}
-			}
+		orchestrators := make([]string, 0, len(confirms))
+		for _, conf := range confirms {
+			orchestrators = append(orchestrators, conf.Orchestrator)
 		}
+		signers := confirmingValidators(ctx, k, orchestrators)
+
+		// only the bridge validator set at the time the logic call was created is expected to confirm it
+		members := bridgeMembers(k.GetValsetAtHeight(ctx, call.Block))
+		confirmLiveness(ctx, k, params, types.CONFIRM_TYPE_LOGIC_CALL, call.Block, candidates, members, func(val *slashingCandidate) bool {
+			return signers[string(val.operator)]
+		}, params.SlashFractionLogicCall)
+
 		// then we set the latest slashed logic call block
 		k.SetLastSlashedLogicCallBlock(ctx, call.Block)
 	}
@@ -362,8 +543,11 @@ func LogicCallSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 // and prune those that are older than the current nonce and no longer have any
 // use. This could be combined with create attestation and save some computation
 // but (A) pruning keeps the iteration small in the first place and (B) there is
-// already enough nuance in the other handler that it's best not to complicate it further
-func pruneAttestations(ctx sdk.Context, k keeper.Keeper) {
+// already enough nuance in the other handler that it's best not to complicate it further.
+// Ethereum height claims older than the last observed one are pruned as well.
+func pruneAttestations(ctx sdk.Context, k keeper.Keeper, params types.Params) {
+	k.PruneEthereumHeightAttestations(ctx)
+
 	attmap := k.GetAttestationMapping(ctx)
 	// We make a slice with all the event nonces that are in the attestation mapping
 	keys := make([]uint64, 0, len(attmap))
@@ -375,8 +559,9 @@ func pruneAttestations(ctx sdk.Context, k keeper.Keeper) {
 
 	// we delete all attestations earlier than the current event nonce
 	// minus some buffer value. This buffer value is purely to allow
-	// frontends and other UI components to view recent oracle history
-	const eventsToKeep = 1000
+	// frontends and other UI components to view recent oracle history,
+	// observed events remain in the archive after they are pruned
+	eventsToKeep := params.AttestationsToKeep
 	lastNonce := uint64(k.GetLastObservedEventNonce(ctx))
 	var cutoff uint64
 	if lastNonce <= eventsToKeep {
@@ -395,6 +580,7 @@ func pruneAttestations(ctx sdk.Context, k keeper.Keeper) {
 		for _, att := range attmap[nonce] {
 			// delete all before the cutoff
 			if nonce < cutoff {
+				k.ArchiveObservedAttestation(ctx, &att)
 				k.DeleteAttestation(ctx, att)
 			}
 		}
@@ -1,6 +1,7 @@
 package gravity
 
 import (
+	"encoding/hex"
 	"fmt"
 	"testing"
 	"time"
@@ -10,9 +11,13 @@ import (
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/keeper"
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func TestValsetCreationIfNotAvailable(t *testing.T) {
@@ -47,6 +52,27 @@ func TestValsetCreationUponUnbonding(t *testing.T) {
 	assert.NotEqual(t, currentValsetNonce, pk.GetLatestValsetNonce(ctx))
 }
 
+func TestValsetCreationUponMaxAge(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+	params.MaxValsetAge = 5
+	pk.SetParams(ctx, params)
+
+	pk.SetValsetRequest(ctx)
+	currentValsetNonce := pk.GetLatestValsetNonce(ctx)
+
+	// the powers did not change so the valset is kept until it is MaxValsetAge blocks old
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.MaxValsetAge) - 1)
+	EndBlocker(ctx, pk)
+	assert.Equal(t, currentValsetNonce, pk.GetLatestValsetNonce(ctx))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	EndBlocker(ctx, pk)
+	assert.Equal(t, currentValsetNonce+1, pk.GetLatestValsetNonce(ctx))
+	assert.Equal(t, uint64(ctx.BlockHeight()), pk.GetLatestValset(ctx).Height)
+}
+
 func TestValsetSlashing_ValsetCreated_Before_ValidatorBonded(t *testing.T) {
 	//	Don't slash validators if valset is created before he is bonded.
 
@@ -103,6 +129,38 @@ func TestValsetSlashing_ValsetCreated_After_ValidatorBonded(t *testing.T) {
 
 }
 
+func TestValsetSlashing_NonMemberNotSlashed(t *testing.T) {
+	//	Only members of the bridge validator set are expected to confirm a valset
+
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	params := input.GravityKeeper.GetParams(ctx)
+	params.MaxBridgeValidators = 4
+	params.MinBridgePower = sdk.NewDecWithPrec(1, 1)
+	pk.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedValsetsWindow) + 2)
+	vs := pk.GetCurrentValset(ctx)
+	require.Len(t, vs.Members, 4)
+	vs.Height = uint64(ctx.BlockHeight()) - (params.SignedValsetsWindow + 1)
+	vs.Nonce = pk.GetLatestValsetNonce(ctx) + 1
+	pk.StoreValsetUnsafe(ctx, vs)
+
+	members := make(map[string]bool)
+	for _, member := range vs.Members {
+		members[member.EthereumAddress] = true
+	}
+
+	// nobody confirms the valset
+	EndBlocker(ctx, pk)
+
+	for i, valAddr := range keeper.ValAddrs {
+		val := input.StakingKeeper.Validator(ctx, valAddr)
+		// only the members are slashed
+		assert.Equal(t, members[keeper.EthAddrs[i].String()], val.IsJailed())
+	}
+}
+
 func TestValsetSlashing_UnbondingValidator_UnbondWindow_NotExpired(t *testing.T) {
 	//	Slashing Conditions for Unbonding Validator
 
@@ -229,6 +287,66 @@ func TestBatchSlashing(t *testing.T) {
 
 }
 
+func TestBatchSlashing_CappedSetAfterValsetPruning(t *testing.T) {
+	// Only the members of the capped bridge validator set a batch was created under are expected to confirm it,
+	// even when that valset would otherwise have been pruned before the batch is checked
+
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+	params.MaxBridgeValidators = 4
+	params.MinBridgePower = sdk.NewDecWithPrec(1, 1)
+	params.SignedBatchesWindow = params.SignedValsetsWindow * 3
+	pk.SetParams(ctx, params)
+
+	// the batch is created under the first valset
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	vs := pk.GetCurrentValset(ctx)
+	require.Len(t, vs.Members, 4)
+	vs.Height = uint64(ctx.BlockHeight())
+	vs.Nonce = pk.GetLatestValsetNonce(ctx) + 1
+	pk.StoreValsetUnsafe(ctx, vs)
+	members := make(map[string]bool)
+	for _, member := range vs.Members {
+		members[member.EthereumAddress] = true
+	}
+	batch := &types.OutgoingTxBatch{
+		BatchNonce:    1,
+		BatchTimeout:  0,
+		Transactions:  []*types.OutgoingTransferTx{},
+		TokenContract: keeper.TokenContractAddrs[0],
+		Block:         vs.Height + 1,
+	}
+	pk.StoreBatchUnsafe(ctx, batch)
+
+	// a later valset is observed on Ethereum and both valsets have already been checked for confirms
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 2)
+	next := *vs
+	next.Height = uint64(ctx.BlockHeight())
+	next.Nonce = vs.Nonce + 1
+	pk.StoreValsetUnsafe(ctx, &next)
+	pk.SetLastObservedValset(ctx, next)
+	pk.SetLastSlashedValsetNonce(ctx, next.Nonce)
+
+	// once the signed valsets window has passed the first valset is kept since the batch has not been checked
+	ctx = ctx.WithBlockHeight(int64(vs.Height+params.SignedValsetsWindow) + 2)
+	EndBlocker(ctx, pk)
+	require.NotNil(t, pk.GetValset(ctx, vs.Nonce))
+	require.Equal(t, uint64(0), pk.GetLastSlashedBatchBlock(ctx))
+
+	// nobody confirms the batch, only the members are slashed for it
+	ctx = ctx.WithBlockHeight(int64(batch.Block+params.SignedBatchesWindow) + 1)
+	EndBlocker(ctx, pk)
+	require.Equal(t, batch.Block, pk.GetLastSlashedBatchBlock(ctx))
+	for i, valAddr := range keeper.ValAddrs {
+		val := input.StakingKeeper.Validator(ctx, valAddr)
+		assert.Equal(t, members[keeper.EthAddrs[i].String()], val.IsJailed())
+	}
+
+	// and now that the batch has been checked the first valset is pruned
+	require.Nil(t, pk.GetValset(ctx, vs.Nonce))
+}
+
 func TestValsetEmission(t *testing.T) {
 	input, ctx := keeper.SetupFiveValChain(t)
 	pk := input.GravityKeeper
@@ -340,3 +458,411 @@ func TestBatchTimeout(t *testing.T) {
 	gotThirdBatch = input.GravityKeeper.GetOutgoingTXBatch(ctx, b3.TokenContract, b3.BatchNonce)
 	require.NotNil(t, gotThirdBatch)
 }
+
+func TestBatchTimeoutSuspendsToken(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5" // Pickle
+		allVouchers         = sdk.NewCoins(
+			types.NewERC20Token(99999, myTokenContractAddr).GravityCoin(),
+		)
+	)
+	require.Equal(t, uint64(3), params.BatchTimeoutSuspensionThreshold)
+
+	// mint some vouchers first
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	// set senders balance
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allVouchers))
+
+	// add some TX to the pool
+	for i, v := range []uint64{2, 3, 2, 1, 5, 6} {
+		amount := types.NewERC20Token(uint64(i+100), myTokenContractAddr).GravityCoin()
+		fee := types.NewERC20Token(v, myTokenContractAddr).GravityCoin()
+		_, err := pk.AddToOutgoingPool(ctx, mySender, myReceiver, amount, fee)
+		require.NoError(t, err)
+	}
+
+	ctx = ctx.WithBlockHeight(250)
+	pk.SetLastObservedEthereumBlockHeight(ctx, 500)
+
+	// batches timing out in the same block count as a single timeout
+	for i := uint64(0); i < params.BatchTimeoutSuspensionThreshold; i++ {
+		_, err := pk.BuildOutgoingTXBatch(ctx, myTokenContractAddr, 1)
+		require.NoError(t, err)
+	}
+	pk.SetLastObservedEthereumBlockHeight(ctx, 5000)
+	EndBlocker(ctx, pk)
+	require.Equal(t, uint64(1), pk.GetConsecutiveBatchTimeouts(ctx, myTokenContractAddr))
+	require.False(t, pk.IsTokenSuspended(ctx, myTokenContractAddr))
+	require.Len(t, pk.GetUnbatchedTransactionsByContract(ctx, myTokenContractAddr), 6)
+
+	// time out one batch per block up to one less than the threshold, this should not suspend the token
+	ethereumHeight := uint64(5000)
+	timeoutBatch := func() {
+		_, err := pk.BuildOutgoingTXBatch(ctx, myTokenContractAddr, 1)
+		require.NoError(t, err)
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		ethereumHeight += 5000
+		pk.SetLastObservedEthereumBlockHeight(ctx, ethereumHeight)
+		EndBlocker(ctx, pk)
+	}
+	for i := uint64(1); i < params.BatchTimeoutSuspensionThreshold-1; i++ {
+		timeoutBatch()
+	}
+	require.Equal(t, params.BatchTimeoutSuspensionThreshold-1, pk.GetConsecutiveBatchTimeouts(ctx, myTokenContractAddr))
+	require.False(t, pk.IsTokenSuspended(ctx, myTokenContractAddr))
+
+	// the next timeout crosses the threshold
+	timeoutBatch()
+	require.True(t, pk.IsTokenSuspended(ctx, myTokenContractAddr))
+	require.Equal(t, []string{myTokenContractAddr}, pk.GetSuspendedTokens(ctx))
+
+	// the pool has been refunded to the sender
+	require.Empty(t, pk.GetUnbatchedTransactionsByContract(ctx, myTokenContractAddr))
+	require.Equal(t, allVouchers, input.BankKeeper.GetAllBalances(ctx, mySender))
+
+	// new transfers and batches are rejected
+	amount := types.NewERC20Token(100, myTokenContractAddr).GravityCoin()
+	fee := types.NewERC20Token(1, myTokenContractAddr).GravityCoin()
+	_, err := pk.AddToOutgoingPool(ctx, mySender, myReceiver, amount, fee)
+	require.True(t, types.ErrTokenSuspended.Is(err))
+	_, err = pk.BuildOutgoingTXBatch(ctx, myTokenContractAddr, 1)
+	require.True(t, types.ErrTokenSuspended.Is(err))
+
+	// governance lifts the suspension
+	proposal := types.NewUnsuspendTokenProposal("unsuspend", "the token has been unpaused", myTokenContractAddr)
+	require.NoError(t, proposal.ValidateBasic())
+	require.NoError(t, NewGravityProposalHandler(pk)(ctx, proposal))
+	require.False(t, pk.IsTokenSuspended(ctx, myTokenContractAddr))
+	require.Equal(t, uint64(0), pk.GetConsecutiveBatchTimeouts(ctx, myTokenContractAddr))
+	require.Error(t, NewGravityProposalHandler(pk)(ctx, proposal))
+
+	_, err = pk.AddToOutgoingPool(ctx, mySender, myReceiver, amount, fee)
+	require.NoError(t, err)
+}
+
+func TestBatchSlashingItemsPerBlock(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+	params.MaxSlashingItemsPerBlock = 2
+	pk.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedBatchesWindow) + 10)
+	oldest := uint64(ctx.BlockHeight()) - params.SignedBatchesWindow - 5
+
+	// batches 2 and 3 are created in the same block and must be checked together
+	for i, block := range []uint64{oldest, oldest + 1, oldest + 1, oldest + 2} {
+		pk.StoreBatchUnsafe(ctx, &types.OutgoingTxBatch{
+			BatchNonce:    uint64(i + 1),
+			BatchTimeout:  0,
+			Transactions:  []*types.OutgoingTransferTx{},
+			TokenContract: keeper.TokenContractAddrs[i],
+			Block:         block,
+		})
+	}
+
+	BatchSlashing(ctx, pk, params)
+	assert.Equal(t, oldest+1, pk.GetLastSlashedBatchBlock(ctx))
+	assert.Len(t, pk.GetUnSlashedBatches(ctx, uint64(ctx.BlockHeight()), 10), 1)
+
+	BatchSlashing(ctx, pk, params)
+	assert.Equal(t, oldest+2, pk.GetLastSlashedBatchBlock(ctx))
+	assert.Len(t, pk.GetUnSlashedBatches(ctx, uint64(ctx.BlockHeight()), 10), 0)
+}
+
+func TestBatchSlashing_MissedConfirmsWindow(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+	params.ConfirmsWindow = 10
+	params.MinConfirmsPerWindow = sdk.NewDecWithPrec(5, 1)
+	params.JailForMissedConfirms = false
+	pk.SetParams(ctx, params)
+	for i, val := range keeper.ValAddrs {
+		pk.SetOrchestratorValidator(ctx, val, keeper.AccAddrs[i])
+	}
+
+	startHeight := uint64(ctx.BlockHeight())
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 100)
+	storeBatches := func(nonces ...uint64) {
+		for _, nonce := range nonces {
+			batch := &types.OutgoingTxBatch{
+				BatchNonce:    nonce,
+				BatchTimeout:  0,
+				Transactions:  []*types.OutgoingTransferTx{},
+				TokenContract: keeper.TokenContractAddrs[0],
+				Block:         startHeight + nonce,
+			}
+			pk.StoreBatchUnsafe(ctx, batch)
+			// every validator but the first confirms
+			for i, orch := range keeper.AccAddrs[1:] {
+				pk.SetBatchConfirm(ctx, &types.MsgConfirmBatch{
+					Nonce:         nonce,
+					TokenContract: keeper.TokenContractAddrs[0],
+					EthSigner:     keeper.EthAddrs[i+1].String(),
+					Orchestrator:  orch.String(),
+					Signature:     "",
+				})
+			}
+		}
+	}
+	tokens := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).GetTokens()
+
+	// missing half of the window is tolerated
+	storeBatches(1, 2, 3, 4, 5)
+	BatchSlashing(ctx, pk, params)
+	assert.Equal(t, tokens, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).GetTokens())
+	assert.Equal(t, uint64(5), pk.GetConfirmLivenessInfo(ctx, types.CONFIRM_TYPE_BATCH, keeper.ValAddrs[0]).MissedConfirmsCounter)
+	assert.Equal(t, uint64(0), pk.GetConfirmLivenessInfo(ctx, types.CONFIRM_TYPE_BATCH, keeper.ValAddrs[1]).MissedConfirmsCounter)
+
+	// one more miss slashes the validator, but jailing is disabled
+	storeBatches(6)
+	BatchSlashing(ctx, pk, params)
+	val := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0])
+	assert.True(t, val.GetTokens().LT(tokens))
+	assert.False(t, val.IsJailed())
+	assert.Equal(t, uint64(0), pk.GetConfirmLivenessInfo(ctx, types.CONFIRM_TYPE_BATCH, keeper.ValAddrs[0]).MissedConfirmsCounter)
+	assert.False(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[1]).IsJailed())
+}
+
+func TestBatchSlashing_UnbondingValidator(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+	params.ConfirmsWindow = 10
+	params.MinConfirmsPerWindow = sdk.NewDecWithPrec(5, 1)
+	pk.SetParams(ctx, params)
+
+	// the first validator starts unbonding
+	startHeight := uint64(ctx.BlockHeight())
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 2)
+	sh := staking.NewHandler(input.StakingKeeper)
+	_, err := sh(ctx, keeper.NewTestMsgUnDelegateValidator(keeper.ValAddrs[0], keeper.StakingAmount))
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, input.StakingKeeper)
+	require.True(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).IsUnbonding())
+
+	// a batch created while it is unbonding is still expected to be confirmed
+	batch := &types.OutgoingTxBatch{
+		BatchNonce:    1,
+		BatchTimeout:  0,
+		Transactions:  []*types.OutgoingTransferTx{},
+		TokenContract: keeper.TokenContractAddrs[0],
+		Block:         startHeight + 3,
+	}
+	pk.StoreBatchUnsafe(ctx, batch)
+	pk.SetOrchestratorValidator(ctx, keeper.ValAddrs[1], keeper.AccAddrs[1])
+	pk.SetBatchConfirm(ctx, &types.MsgConfirmBatch{
+		Nonce:         batch.BatchNonce,
+		TokenContract: batch.TokenContract,
+		EthSigner:     keeper.EthAddrs[1].String(),
+		Orchestrator:  keeper.AccAddrs[1].String(),
+		Signature:     "",
+	})
+
+	ctx = ctx.WithBlockHeight(int64(batch.Block + params.SignedBatchesWindow + 1))
+	BatchSlashing(ctx, pk, params)
+
+	unbondingInfo := pk.GetConfirmLivenessInfo(ctx, types.CONFIRM_TYPE_BATCH, keeper.ValAddrs[0])
+	assert.Equal(t, uint64(1), unbondingInfo.IndexOffset)
+	assert.Equal(t, uint64(1), unbondingInfo.MissedConfirmsCounter)
+	confirmedInfo := pk.GetConfirmLivenessInfo(ctx, types.CONFIRM_TYPE_BATCH, keeper.ValAddrs[1])
+	assert.Equal(t, uint64(1), confirmedInfo.IndexOffset)
+	assert.Equal(t, uint64(0), confirmedInfo.MissedConfirmsCounter)
+}
+
+// slashingBenchmarkValidators is the size of the validator set slashing is benchmarked against
+const slashingBenchmarkValidators = 150
+
+// setupSlashingBenchmark creates a chain with slashingBenchmarkValidators validators where every validator
+// but the last has confirmed the valsets, batches and logic calls stored by the caller. The returned context
+// is far enough ahead that every item is ready to be slashed, as it would be after a long outage.
+func setupSlashingBenchmark(b *testing.B) (keeper.TestInput, sdk.Context, []stakingtypes.Validator) {
+	input, ctx := keeper.SetupTestChain(b, slashingBenchmarkValidators)
+	validators := input.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	require.Len(b, validators, slashingBenchmarkValidators)
+	return input, ctx.WithBlockHeight(ctx.BlockHeight() + 1000), validators
+}
+
+func BenchmarkValsetSlashing(b *testing.B) {
+	input, ctx, validators := setupSlashingBenchmark(b)
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+
+	vs := pk.GetCurrentValset(ctx)
+	for nonce := uint64(1); nonce <= 100; nonce++ {
+		vs.Nonce = nonce
+		vs.Height = nonce + 1
+		pk.StoreValsetUnsafe(ctx, vs)
+		for _, val := range validators[:len(validators)-1] {
+			ethAddr, _ := pk.GetEthAddressByValidator(ctx, val.GetOperator())
+			pk.SetValsetConfirm(ctx, *types.NewMsgValsetConfirm(nonce, ethAddr, sdk.AccAddress(val.GetOperator()), "dummysig"))
+		}
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		cacheCtx, _ := ctx.CacheContext()
+		ValsetSlashing(cacheCtx, pk, params)
+	}
+}
+
+func BenchmarkBatchSlashing(b *testing.B) {
+	input, ctx, validators := setupSlashingBenchmark(b)
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+
+	for nonce := uint64(1); nonce <= 100; nonce++ {
+		pk.StoreBatchUnsafe(ctx, &types.OutgoingTxBatch{
+			BatchNonce:    nonce,
+			BatchTimeout:  0,
+			Transactions:  []*types.OutgoingTransferTx{},
+			TokenContract: keeper.TokenContractAddrs[0],
+			Block:         nonce + 1,
+		})
+		for _, val := range validators[:len(validators)-1] {
+			ethAddr, _ := pk.GetEthAddressByValidator(ctx, val.GetOperator())
+			pk.SetBatchConfirm(ctx, &types.MsgConfirmBatch{
+				Nonce:         nonce,
+				TokenContract: keeper.TokenContractAddrs[0],
+				EthSigner:     ethAddr,
+				Orchestrator:  sdk.AccAddress(val.GetOperator()).String(),
+				Signature:     "",
+			})
+		}
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		cacheCtx, _ := ctx.CacheContext()
+		BatchSlashing(cacheCtx, pk, params)
+	}
+}
+
+func BenchmarkLogicCallSlashing(b *testing.B) {
+	input, ctx, validators := setupSlashingBenchmark(b)
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+
+	invalidationID := []byte("benchmark")
+	for nonce := uint64(1); nonce <= 100; nonce++ {
+		pk.SetOutgoingLogicCall(ctx, &types.OutgoingLogicCall{
+			Transfers:            []*types.ERC20Token{},
+			Fees:                 []*types.ERC20Token{},
+			LogicContractAddress: keeper.TokenContractAddrs[0],
+			Payload:              []byte{},
+			Timeout:              0,
+			InvalidationId:       invalidationID,
+			InvalidationNonce:    nonce,
+			Block:                nonce + 1,
+		})
+		for _, val := range validators[:len(validators)-1] {
+			ethAddr, _ := pk.GetEthAddressByValidator(ctx, val.GetOperator())
+			pk.SetLogicCallConfirm(ctx, &types.MsgConfirmLogicCall{
+				InvalidationId:    hex.EncodeToString(invalidationID),
+				InvalidationNonce: nonce,
+				EthSigner:         ethAddr,
+				Orchestrator:      sdk.AccAddress(val.GetOperator()).String(),
+				Signature:         "",
+			})
+		}
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		cacheCtx, _ := ctx.CacheContext()
+		LogicCallSlashing(cacheCtx, pk, params)
+	}
+}
+
+func TestJailValidatorsWithoutKeys(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+	EndBlocker(ctx, pk)
+
+	// bond two new validators without delegate keys
+	sh := staking.NewHandler(input.StakingKeeper)
+	var newVals []sdk.ValAddress
+	for i := 0; i < 2; i++ {
+		accPubKey := secp256k1.GenPrivKey().PubKey()
+		accAddr := sdk.AccAddress(accPubKey.Address())
+		valAddr := sdk.ValAddress(accAddr)
+		acc := input.AccountKeeper.NewAccount(ctx, authtypes.NewBaseAccount(accAddr, accPubKey, uint64(10+i), 0))
+		require.NoError(t, input.BankKeeper.SetBalances(ctx, accAddr, keeper.InitCoins))
+		input.AccountKeeper.SetAccount(ctx, acc)
+		_, err := sh(ctx, keeper.NewTestMsgCreateValidator(valAddr, ed25519.GenPrivKey().PubKey(), keeper.StakingAmount))
+		require.NoError(t, err)
+		newVals = append(newVals, valAddr)
+	}
+	staking.EndBlocker(ctx, input.StakingKeeper)
+	for _, val := range newVals {
+		bondedHeight, found := pk.GetValidatorAwaitingKeys(ctx, val)
+		require.True(t, found)
+		require.Equal(t, uint64(ctx.BlockHeight()), bondedHeight)
+	}
+	// the original validators already have keys
+	_, found := pk.GetValidatorAwaitingKeys(ctx, keeper.ValAddrs[0])
+	require.False(t, found)
+
+	// the first new validator registers keys, this triggers a new valset that includes it
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	valsetNonce := pk.GetLatestValsetNonce(ctx)
+	_, err := keeper.NewMsgServerImpl(pk).SetOrchestratorAddress(sdk.WrapSDKContext(ctx), types.NewMsgSetOrchestratorAddress(
+		newVals[0], sdk.AccAddress(newVals[0]), "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
+	))
+	require.NoError(t, err)
+	_, found = pk.GetValidatorAwaitingKeys(ctx, newVals[0])
+	require.False(t, found)
+	EndBlocker(ctx, pk)
+	require.Equal(t, valsetNonce+1, pk.GetLatestValsetNonce(ctx))
+	require.Len(t, pk.GetValset(ctx, pk.GetLatestValsetNonce(ctx)).Members, 6)
+
+	// the second is not jailed before the end of the grace period
+	bondedHeight, _ := pk.GetValidatorAwaitingKeys(ctx, newVals[1])
+	ctx = ctx.WithBlockHeight(int64(bondedHeight+params.KeyRegistrationGracePeriod) - 1)
+	EndBlocker(ctx, pk)
+	require.False(t, input.StakingKeeper.Validator(ctx, newVals[1]).IsJailed())
+
+	// but it is jailed once the grace period is over
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	EndBlocker(ctx, pk)
+	require.True(t, input.StakingKeeper.Validator(ctx, newVals[1]).IsJailed())
+	require.False(t, input.StakingKeeper.Validator(ctx, newVals[0]).IsJailed())
+	_, found = pk.GetValidatorAwaitingKeys(ctx, newVals[1])
+	require.False(t, found)
+	var jailedEvents int
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		typed, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			continue
+		}
+		if jailed, ok := typed.(*types.EventValidatorJailed); ok {
+			require.Equal(t, newVals[1].String(), jailed.Validator)
+			require.Equal(t, types.SLASHING_REASON_MISSING_DELEGATE_KEYS, jailed.Reason)
+			jailedEvents++
+		}
+	}
+	require.Equal(t, 1, jailedEvents)
+
+	// the jailed validator can't unjail before the downtime jail duration has passed
+	err = input.SlashingKeeper.Unjail(ctx, newVals[1])
+	require.True(t, slashingtypes.ErrValidatorJailed.Is(err))
+	jailDuration := input.SlashingKeeper.DowntimeJailDuration(ctx)
+	require.NoError(t, input.SlashingKeeper.Unjail(ctx.WithBlockTime(ctx.BlockTime().Add(jailDuration)), newVals[1]))
+
+	// removing a validator frees its delegate keys
+	pk.Hooks().AfterValidatorRemoved(ctx, nil, newVals[0])
+	_, found = pk.GetEthAddressByValidator(ctx, newVals[0])
+	require.False(t, found)
+	_, found = pk.GetValidatorByEthAddress(ctx, "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+	require.False(t, found)
+	_, found = pk.GetOrchestratorValidatorAddr(ctx, sdk.AccAddress(newVals[0]))
+	require.False(t, found)
+}
@@ -0,0 +1,68 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/keeper"
+)
+
+// OrchestratorMsgDecorator rejects confirms and claims that are bound to fail in DeliverTx before they
+// reach the mempool, see keeper.CheckOrchestratorMsg for the checks performed. On CheckTx and ReCheckTx the
+// accepted messages are recorded in the check state, so it must come after signature verification. In
+// DeliverTx the message handlers perform the same checks, only fee exempt transactions are checked here
+// so that they can't fill blocks with failing messages for free. It also consumes the fee exempt quota.
+type OrchestratorMsgDecorator struct {
+	k keeper.Keeper
+}
+
+// NewOrchestratorMsgDecorator returns a new OrchestratorMsgDecorator
+func NewOrchestratorMsgDecorator(k keeper.Keeper) OrchestratorMsgDecorator {
+	return OrchestratorMsgDecorator{k: k}
+}
+
+// AnteHandle implements sdk.AnteDecorator
+func (d OrchestratorMsgDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	exempt := d.k.IsFeeExempt(ctx, tx)
+
+	// simulations execute the messages after the ante handler, recording them here would make them fail
+	checkCtx := ctx
+	if !ctx.IsCheckTx() || simulate {
+		if !exempt {
+			return next(ctx, tx, simulate)
+		}
+		checkCtx, _ = ctx.CacheContext()
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		if err := d.k.CheckOrchestratorMsg(checkCtx, msg); err != nil {
+			return ctx, err
+		}
+	}
+
+	if exempt {
+		d.k.ConsumeFeeExemptQuota(ctx, tx)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// FeeExemptionDecorator skips the wrapped fee decorator for transactions that are exempt from fees,
+// see keeper.IsFeeExempt. The quota of exempt messages is consumed by OrchestratorMsgDecorator, which
+// must therefore be part of the same ante chain.
+type FeeExemptionDecorator struct {
+	k     keeper.Keeper
+	inner sdk.AnteDecorator
+}
+
+// NewFeeExemptionDecorator returns a new FeeExemptionDecorator wrapping the given fee decorator
+func NewFeeExemptionDecorator(k keeper.Keeper, inner sdk.AnteDecorator) FeeExemptionDecorator {
+	return FeeExemptionDecorator{k: k, inner: inner}
+}
+
+// AnteHandle implements sdk.AnteDecorator
+func (d FeeExemptionDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if d.k.IsFeeExempt(ctx, tx) {
+		return next(ctx, tx, simulate)
+	}
+	return d.inner.AnteHandle(ctx, tx, simulate, next)
+}
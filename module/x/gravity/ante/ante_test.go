@@ -0,0 +1,98 @@
+package ante
+
+import (
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/keeper"
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// requireFees stands in for the fee decorators and rejects every transaction that reaches it
+type requireFees struct{}
+
+func (requireFees) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	return ctx, sdkerrors.ErrInsufficientFee
+}
+
+//nolint: exhaustivestruct
+func TestFeeExemptOrchestratorTxs(t *testing.T) {
+	input, ctx := keeper.SetupTestChain(t, 2)
+	k := input.GravityKeeper
+	validator := input.StakingKeeper.GetBondedValidatorsByPower(ctx)[0]
+	orch := sdk.AccAddress(validator.GetOperator())
+	ethKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	ethAddr := crypto.PubkeyToAddress(ethKey.PublicKey).String()
+	k.SetEthAddressForValidator(ctx, validator.GetOperator(), ethAddr)
+
+	params := k.GetParams(ctx)
+	params.FeeExemptOrchestratorMsgs = 3
+	params.FeeExemptMaxGas = 200000
+	k.SetParams(ctx, params)
+
+	anteHandler := sdk.ChainAnteDecorators(NewFeeExemptionDecorator(k, requireFees{}), NewOrchestratorMsgDecorator(k))
+	deliver := func(gas uint64, msgs ...sdk.Msg) error {
+		_, err := anteHandler(ctx, legacytx.NewStdTx(msgs, legacytx.StdFee{Gas: gas}, nil, ""), false)
+		return err
+	}
+
+	valset := k.SetValsetRequest(ctx)
+	sig, err := types.NewEthereumSignature(valset.GetCheckpoint(k.GetGravityID(ctx)), ethKey)
+	require.NoError(t, err)
+	confirm := &types.MsgValsetConfirm{Nonce: valset.Nonce, Orchestrator: orch.String(), EthAddress: ethAddr, Signature: hex.EncodeToString(sig)}
+	deposit := func(nonce uint64) *types.MsgSendToCosmosClaim {
+		return &types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			TokenContract:  "0x0bc529c00C6401aEF6D220BE8C6Ea1667F6Ad93e",
+			Amount:         sdk.NewInt(100),
+			EthereumSender: keeper.EthAddrs[0].String(),
+			CosmosReceiver: keeper.AccAddrs[0].String(),
+			Orchestrator:   orch.String(),
+		}
+	}
+
+	// transactions with other messages or over the gas cap pay fees
+	assert.True(t, sdkerrors.ErrInsufficientFee.Is(deliver(100000, confirm, &types.MsgSendToEth{Sender: orch.String()})))
+	assert.True(t, sdkerrors.ErrInsufficientFee.Is(deliver(200001, confirm)))
+	assert.Equal(t, uint64(0), k.GetFeeExemptMsgCount(ctx, orch))
+
+	// exempt transactions are checked before they are executed and only count towards the quota if they pass
+	unknown := &types.MsgValsetConfirm{Nonce: valset.Nonce + 1, Orchestrator: orch.String(), EthAddress: ethAddr, Signature: "signature"}
+	assert.True(t, types.ErrInvalid.Is(deliver(100000, unknown)))
+	badSignature := *confirm
+	badSignature.Signature = "signature"
+	assert.True(t, types.ErrInvalid.Is(deliver(100000, &badSignature)))
+	assert.Equal(t, uint64(0), k.GetFeeExemptMsgCount(ctx, orch))
+	require.NoError(t, deliver(200000, confirm))
+	assert.Equal(t, uint64(1), k.GetFeeExemptMsgCount(ctx, orch))
+
+	// every claim counts towards the quota, once it is used up the orchestrator pays fees
+	claims, err := types.NewMsgSubmitClaims(orch, deposit(1), deposit(2))
+	require.NoError(t, err)
+	require.NoError(t, deliver(100000, claims))
+	assert.Equal(t, uint64(3), k.GetFeeExemptMsgCount(ctx, orch))
+	assert.True(t, sdkerrors.ErrInsufficientFee.Is(deliver(100000, confirm)))
+	assert.Equal(t, uint64(3), k.GetFeeExemptMsgCount(ctx, orch))
+
+	// the orchestrators of validators that are no longer bonded pay fees
+	other := input.StakingKeeper.GetBondedValidatorsByPower(ctx)[1]
+	otherOrch := sdk.AccAddress(other.GetOperator())
+	otherEthAddr, found := k.GetEthAddressByValidator(ctx, other.GetOperator())
+	require.True(t, found)
+	otherConfirm := &types.MsgValsetConfirm{Nonce: valset.Nonce, Orchestrator: otherOrch.String(), EthAddress: otherEthAddr, Signature: "signature"}
+	cons, err := other.GetConsAddr()
+	require.NoError(t, err)
+	input.StakingKeeper.Jail(ctx, cons)
+	staking.EndBlocker(ctx, input.StakingKeeper)
+	assert.True(t, sdkerrors.ErrInsufficientFee.Is(deliver(100000, otherConfirm)))
+	assert.Equal(t, uint64(0), k.GetFeeExemptMsgCount(ctx, otherOrch))
+}
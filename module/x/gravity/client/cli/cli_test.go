@@ -0,0 +1,241 @@
+package cli_test
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/suite"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/app"
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/client/cli"
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	cfg     network.Config
+	network *network.Network
+}
+
+// networkConfig returns a single validator test network running the gravity app
+func networkConfig() network.Config {
+	encCfg := app.MakeEncodingConfig()
+	cfg := network.DefaultConfig()
+	cfg.Codec = encCfg.Marshaler
+	cfg.TxConfig = encCfg.TxConfig
+	cfg.LegacyAmino = encCfg.Amino
+	cfg.InterfaceRegistry = encCfg.InterfaceRegistry
+	cfg.GenesisState = app.ModuleBasics.DefaultGenesis(encCfg.Marshaler)
+	cfg.NumValidators = 1
+	cfg.KeyringOptions = []keyring.Option{types.EthSecp256k1Option()}
+	cfg.AppConstructor = func(val network.Validator) servertypes.Application {
+		return app.NewGravityApp(
+			val.Ctx.Logger, dbm.NewMemDB(), nil, true, make(map[int64]bool), val.Ctx.Config.RootDir, 0,
+			encCfg,
+			simapp.EmptyAppOptions{},
+			baseapp.SetPruning(storetypes.NewPruningOptionsFromString(val.AppConfig.Pruning)),
+			baseapp.SetMinGasPrices(val.AppConfig.MinGasPrices),
+		)
+	}
+	return cfg
+}
+
+func (s *IntegrationTestSuite) SetupSuite() {
+	s.T().Log("setting up integration test suite")
+
+	s.cfg = networkConfig()
+	s.network = network.New(s.T(), s.cfg)
+
+	_, err := s.network.WaitForHeight(1)
+	s.Require().NoError(err)
+}
+
+func (s *IntegrationTestSuite) TearDownSuite() {
+	s.T().Log("tearing down integration test suite")
+	s.network.Cleanup()
+}
+
+func (s *IntegrationTestSuite) TestGetParams() {
+	val := s.network.Validators[0]
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.CmdGetParams(), []string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err)
+
+	var params types.Params
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &params))
+	s.Require().Equal(types.DefaultParams().GravityId, params.GravityId)
+}
+
+func (s *IntegrationTestSuite) TestQueries() {
+	val := s.network.Validators[0]
+	jsonFlag := fmt.Sprintf("--%s=json", tmcli.OutputFlag)
+
+	testCases := []struct {
+		name      string
+		cmd       *cobra.Command
+		args      []string
+		expectErr bool
+	}{
+		{"current valset", cli.CmdGetCurrentValset(), []string{}, false},
+		{"bridge status", cli.CmdGetBridgeStatus(), []string{}, false},
+		{"valset requests", cli.CmdGetValsetRequests(), []string{fmt.Sprintf("--%s=1", flags.FlagLimit)}, false},
+		{"invalid valset nonce", cli.CmdGetValsetRequest(), []string{"one"}, true},
+		{"batch fees", cli.CmdGetBatchFees(), []string{}, false},
+		{"outgoing batches", cli.CmdGetOutgoingTXBatches(), []string{}, false},
+		{"outgoing batches of an invalid token", cli.CmdGetOutgoingTXBatches(), []string{fmt.Sprintf("--token-contract=%s", "0xinvalid")}, true},
+		{"outgoing logic calls", cli.CmdGetOutgoingLogicCalls(), []string{}, false},
+		{"invalid logic call invalidation id", cli.CmdGetLogicConfirms(), []string{"zz", "1"}, true},
+		{"attestations", cli.CmdGetAttestations(), []string{}, false},
+		{"observed events", cli.CmdGetObservedEvents(), []string{"--claim-type=send_to_cosmos"}, false},
+		{"observed events of an unknown claim type", cli.CmdGetObservedEvents(), []string{"--claim-type=unknown"}, true},
+		{"delegate keys of a validator without keys", cli.CmdGetDelegateKeysByValidator(), []string{val.ValAddress.String()}, true},
+		{"pending send to eth", cli.CmdGetPendingSendToEth(), []string{val.Address.String(), "--batches-limit=1"}, false},
+		{"last observed eth height", cli.CmdGetLastObservedEthereumHeight(), []string{}, false},
+		{"orchestrator versions", cli.CmdGetOrchestratorVersions(), []string{fmt.Sprintf("--%s=1", flags.FlagLimit)}, false},
+		{"block times", cli.CmdGetBlockTimes(), []string{}, false},
+		{"bad signature evidence", cli.CmdGetBadSignatureEvidence(), []string{fmt.Sprintf("--%s=1", flags.FlagLimit)}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, tc.cmd, append(tc.args, jsonFlag))
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err, out.String())
+			}
+		})
+	}
+}
+
+// execTxCmd executes a tx command that prompts for input, such as an Ethereum key passphrase
+func execTxCmd(clientCtx client.Context, cmd *cobra.Command, in string, args []string) (testutil.BufferWriter, error) {
+	cmd.SetArgs(args)
+	_, out := testutil.ApplyMockIO(cmd)
+	cmd.SetIn(strings.NewReader(in))
+	clientCtx = clientCtx.WithOutput(out)
+
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+	return out, cmd.ExecuteContext(ctx)
+}
+
+func (s *IntegrationTestSuite) TestValsetConfirm() {
+	const (
+		// the development mnemonic of hardhat and many other Ethereum tools
+		mnemonic   = "test test test test test test test test test test test junk"
+		passphrase = "passphrase"
+	)
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx.WithKeyringDir(s.T().TempDir())
+
+	info, err := clientCtx.Keyring.NewAccount("eth", mnemonic, "", types.EthereumHDPath, types.EthSecp256k1)
+	s.Require().NoError(err)
+	keyringAddress, err := types.EthAddressFromKey(info)
+	s.Require().NoError(err)
+	ks := keystore.NewKeyStore(clientCtx.KeyringDir, keystore.LightScryptN, keystore.LightScryptP)
+	account, err := ks.NewAccount(passphrase)
+	s.Require().NoError(err)
+	keystoreAddress := account.Address.Hex()
+
+	// the valset requested when the chain started
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.CmdGetValsetRequest(), []string{"1", fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err)
+	var valset types.QueryValsetRequestResponse
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &valset))
+	s.Require().NotNil(valset.Valset)
+	checkpoint := valset.Valset.GetCheckpoint(types.DefaultParams().GravityId)
+
+	txFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+	}
+	testCases := []struct {
+		name       string
+		args       []string
+		in         string
+		ethAddress string
+		expectErr  bool
+	}{
+		{"keyring key", []string{"1", "--eth-key=eth"}, "", keyringAddress, false},
+		{"keystore key", []string{"1", "--eth-address=" + keystoreAddress}, passphrase + "\n", keystoreAddress, false},
+		{"wrong keystore passphrase", []string{"1", "--eth-address=" + keystoreAddress}, "wrong\n", "", true},
+		{"unknown keyring key", []string{"1", "--eth-key=unknown"}, "", "", true},
+		{"cosmos keyring key", []string{"1", "--eth-key=" + val.Moniker}, "", "", true},
+		{"unknown valset", []string{"1000", "--eth-key=eth"}, "", "", true},
+		{"invalid nonce", []string{"one", "--eth-key=eth"}, "", "", true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			out, err := execTxCmd(clientCtx, cli.CmdValsetConfirm(), tc.in, append(tc.args, txFlags...))
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err, out.String())
+
+			tx, err := clientCtx.TxConfig.TxJSONDecoder()(out.Bytes())
+			s.Require().NoError(err)
+			s.Require().Len(tx.GetMsgs(), 1)
+			msg, ok := tx.GetMsgs()[0].(*types.MsgValsetConfirm)
+			s.Require().True(ok)
+			s.Require().Equal(uint64(1), msg.Nonce)
+			s.Require().Equal(tc.ethAddress, msg.EthAddress)
+			s.Require().Equal(val.Address.String(), msg.Orchestrator)
+
+			signature, err := hex.DecodeString(msg.Signature)
+			s.Require().NoError(err)
+			s.Require().NoError(types.ValidateEthereumSignature(checkpoint, signature, tc.ethAddress))
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestEthereumHeightClaim() {
+	val := s.network.Validators[0]
+	txFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+	}
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.CmdEthereumHeightClaim(), append([]string{"1234", "--orchestrator-version=v1.0.0"}, txFlags...))
+	s.Require().NoError(err)
+	tx, err := val.ClientCtx.TxConfig.TxJSONDecoder()(out.Bytes())
+	s.Require().NoError(err)
+	s.Require().Len(tx.GetMsgs(), 1)
+	msg, ok := tx.GetMsgs()[0].(*types.MsgEthereumHeightClaim)
+	s.Require().True(ok)
+	// the height is rounded down to the claim granularity and the nonce derived from it
+	s.Require().Equal(uint64(1230), msg.BlockHeight)
+	s.Require().Equal(uint64(123), msg.HeightNonce)
+	s.Require().Equal("v1.0.0", msg.OrchestratorVersion)
+	s.Require().Equal(val.Address.String(), msg.Orchestrator)
+
+	_, err = clitestutil.ExecTestCLICmd(val.ClientCtx, cli.CmdEthereumHeightClaim(), append([]string{"tall"}, txFlags...))
+	s.Require().Error(err)
+	// the height nonce is no longer an argument
+	_, err = clitestutil.ExecTestCLICmd(val.ClientCtx, cli.CmdEthereumHeightClaim(), append([]string{"123", "1234"}, txFlags...))
+	s.Require().Error(err)
+}
+
+func TestIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationTestSuite))
+}
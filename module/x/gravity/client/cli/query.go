@@ -1,15 +1,29 @@
 package cli
 
 import (
+	"encoding/hex"
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/spf13/cobra"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 )
 
+const (
+	flagTokenContract = "token-contract"
+	flagStartNonce    = "start-nonce"
+	flagEndNonce      = "end-nonce"
+	flagClaimType     = "claim-type"
+	flagBatchesKey    = "batches-page-key"
+	flagBatchesLimit  = "batches-limit"
+)
+
 func GetQueryCmd() *cobra.Command {
 	//nolint: exhaustivestruct
 	gravityQueryCmd := &cobra.Command{
@@ -20,58 +34,41 @@ func GetQueryCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 	gravityQueryCmd.AddCommand([]*cobra.Command{
+		CmdGetParams(),
+		CmdGetBridgeStatus(),
 		CmdGetCurrentValset(),
 		CmdGetValsetRequest(),
+		CmdGetValsetRequests(),
 		CmdGetValsetConfirm(),
+		CmdGetValsetConfirms(),
 		CmdGetPendingValsetRequest(),
 		CmdGetPendingOutgoingTXBatchRequest(),
-		// CmdGetAllOutgoingTXBatchRequest(),
-		// CmdGetOutgoingTXBatchByNonceRequest(),
-		// CmdGetAllAttestationsRequest(),
-		// CmdGetAttestationRequest(),
-		QueryObserved(),
-		QueryApproved(),
+		CmdGetPendingLogicCall(),
+		CmdGetLastEventNonce(),
+		CmdGetBatchFees(),
+		CmdGetOutgoingTXBatches(),
+		CmdGetOutgoingTXBatch(),
+		CmdGetBatchConfirms(),
+		CmdGetOutgoingLogicCalls(),
+		CmdGetLogicConfirms(),
+		CmdGetERC20ToDenom(),
+		CmdGetDenomToERC20(),
+		CmdGetAttestations(),
+		CmdGetAttestationDetail(),
+		CmdGetObservedEvents(),
+		CmdGetDelegateKeysByValidator(),
+		CmdGetDelegateKeysByOrchestrator(),
+		CmdGetDelegateKeysByEth(),
+		CmdGetPendingSendToEth(),
+		CmdGetLastObservedEthereumHeight(),
+		CmdGetOrchestratorVersions(),
+		CmdGetBlockTimes(),
+		CmdGetBadSignatureEvidence(),
 	}...)
 
 	return gravityQueryCmd
 }
 
-func QueryObserved() *cobra.Command {
-	//nolint: exhaustivestruct
-	testingTxCmd := &cobra.Command{
-		Use:                        "observed",
-		Short:                      "observed ETH events",
-		DisableFlagParsing:         true,
-		SuggestionsMinimumDistance: 2,
-		RunE:                       client.ValidateCmd,
-	}
-	testingTxCmd.AddCommand([]*cobra.Command{
-		// CmdGetLastObservedNonceRequest(storeKey, cdc),
-		// CmdGetLastObservedNoncesRequest(storeKey, cdc),
-		// CmdGetLastObservedMultiSigUpdateRequest(storeKey, cdc),
-		// CmdGetAllBridgedDenominatorsRequest(storeKey, cdc),
-	}...)
-
-	return testingTxCmd
-}
-func QueryApproved() *cobra.Command {
-	//nolint: exhaustivestruct
-	testingTxCmd := &cobra.Command{
-		Use:                        "approved",
-		Short:                      "approved cosmos operation",
-		DisableFlagParsing:         true,
-		SuggestionsMinimumDistance: 2,
-		RunE:                       client.ValidateCmd,
-	}
-	testingTxCmd.AddCommand([]*cobra.Command{
-		// CmdGetLastApprovedNoncesRequest(storeKey, cdc),
-		// CmdGetLastApprovedMultiSigUpdateRequest(storeKey, cdc),
-		// CmdGetInflightBatchesRequest(storeKey, cdc),
-	}...)
-
-	return testingTxCmd
-}
-
 func CmdGetCurrentValset() *cobra.Command {
 	//nolint: exhaustivestruct
 	cmd := &cobra.Command{
@@ -210,3 +207,786 @@ func CmdGetPendingOutgoingTXBatchRequest() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdGetBridgeStatus() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Get a summary of the bridge health: observed nonces, pending batches and logic calls and the standing of every bonded validator",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryBridgeStatusRequest{}
+
+			res, err := queryClient.BridgeStatus(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetParams() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current gravity parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetValsetRequests() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "valset-requests",
+		Short: "Get the 5 latest valset requests, or page through all of them with the pagination flags",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryLastValsetRequestsRequest{}
+			if cmd.Flags().Changed(flags.FlagPage) || cmd.Flags().Changed(flags.FlagPageKey) ||
+				cmd.Flags().Changed(flags.FlagOffset) || cmd.Flags().Changed(flags.FlagLimit) {
+				pageReq, err := client.ReadPageRequest(cmd.Flags())
+				if err != nil {
+					return err
+				}
+				req.Pagination = pageReq
+			}
+
+			res, err := queryClient.LastValsetRequests(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "valset requests")
+	return cmd
+}
+
+func CmdGetValsetConfirms() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "valset-confirms [nonce]",
+		Short: "Get all the confirmations of the valset with a particular nonce",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			nonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryValsetConfirmsByNonceRequest{
+				Nonce:      nonce,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ValsetConfirmsByNonce(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "valset confirms")
+	return cmd
+}
+
+func CmdGetPendingLogicCall() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "pending-logic-call [bech32 orchestrator address]",
+		Short: "Get the latest outgoing logic call which has not been signed by a particular orchestrator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryLastPendingLogicCallByAddrRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.LastPendingLogicCallByAddr(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetLastEventNonce() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "last-event-nonce [bech32 orchestrator address]",
+		Short: "Get the nonce of the last Ethereum event claimed by a particular orchestrator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryLastEventNonceByAddrRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.LastEventNonceByAddr(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetBatchFees() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "batch-fees",
+		Short: "Get the fees the next batch of each token in the pool would have",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BatchFees(cmd.Context(), &types.QueryBatchFeeRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "batch fees")
+	return cmd
+}
+
+func CmdGetOutgoingTXBatches() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "outgoing-batches",
+		Short: "Get the outgoing TX batches that are not executed yet, optionally of a single token",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			tokenContract, err := cmd.Flags().GetString(flagTokenContract)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryOutgoingTxBatchesRequest{
+				TokenContract: tokenContract,
+				Pagination:    pageReq,
+			}
+
+			res, err := queryClient.OutgoingTxBatches(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(flagTokenContract, "", "only return the batches of this token contract")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "outgoing batches")
+	return cmd
+}
+
+func CmdGetOutgoingTXBatch() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "outgoing-batch [nonce] [token contract]",
+		Short: "Get the outgoing TX batch of a token with a particular nonce",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			nonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryBatchRequestByNonceRequest{
+				Nonce:           nonce,
+				ContractAddress: args[1],
+			}
+
+			res, err := queryClient.BatchRequestByNonce(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetBatchConfirms() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "batch-confirms [nonce] [token contract]",
+		Short: "Get all the confirmations of the outgoing TX batch of a token with a particular nonce",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			nonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryBatchConfirmsRequest{
+				Nonce:           nonce,
+				ContractAddress: args[1],
+				Pagination:      pageReq,
+			}
+
+			res, err := queryClient.BatchConfirms(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "batch confirms")
+	return cmd
+}
+
+func CmdGetOutgoingLogicCalls() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "outgoing-logic-calls",
+		Short: "Get the outgoing logic calls that are not executed yet",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.OutgoingLogicCalls(cmd.Context(), &types.QueryOutgoingLogicCallsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "outgoing logic calls")
+	return cmd
+}
+
+func CmdGetLogicConfirms() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "logic-confirms [hex invalidation id] [invalidation nonce]",
+		Short: "Get all the confirmations of an outgoing logic call",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			invalidationID, err := hex.DecodeString(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalidation id")
+			}
+			invalidationNonce, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryLogicConfirmsRequest{
+				InvalidationId:    invalidationID,
+				InvalidationNonce: invalidationNonce,
+				Pagination:        pageReq,
+			}
+
+			res, err := queryClient.LogicConfirms(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "logic confirms")
+	return cmd
+}
+
+func CmdGetERC20ToDenom() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "erc20-to-denom [token contract]",
+		Short: "Get the Cosmos denom representing an ERC20 token",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ERC20ToDenom(cmd.Context(), &types.QueryERC20ToDenomRequest{Erc20: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetDenomToERC20() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "denom-to-erc20 [denom]",
+		Short: "Get the ERC20 token representing a Cosmos denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DenomToERC20(cmd.Context(), &types.QueryDenomToERC20Request{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetAttestations() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "attestations",
+		Short: "Get the attestations in state by ascending event nonce, optionally of a nonce range and claim type",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startNonce, endNonce, claimType, err := readEventFilterFlags(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAttestationsRequest{
+				ClaimType:  claimType,
+				StartNonce: startNonce,
+				EndNonce:   endNonce,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.GetAttestations(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	addEventFilterFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "attestations")
+	return cmd
+}
+
+func CmdGetAttestationDetail() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "attestation [event nonce]",
+		Short: "Get the competing claims at an event nonce, their votes and the validators that did not vote",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			nonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AttestationDetail(cmd.Context(), &types.QueryAttestationDetailRequest{EventNonce: nonce})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetObservedEvents() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "observed-events",
+		Short: "Get the archive of observed Ethereum events, optionally of a nonce range and claim type",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startNonce, endNonce, claimType, err := readEventFilterFlags(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryObservedEventsRequest{
+				StartNonce: startNonce,
+				EndNonce:   endNonce,
+				ClaimType:  claimType,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ObservedEvents(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	addEventFilterFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "observed events")
+	return cmd
+}
+
+func CmdGetDelegateKeysByValidator() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "delegate-keys-by-validator [bech32 validator address]",
+		Short: "Get the orchestrator and Ethereum address registered by a validator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDelegateKeysByValidatorAddress{ValidatorAddress: args[0]}
+
+			res, err := queryClient.GetDelegateKeyByValidator(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetDelegateKeysByOrchestrator() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "delegate-keys-by-orchestrator [bech32 orchestrator address]",
+		Short: "Get the validator and Ethereum address an orchestrator is registered with",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDelegateKeysByOrchestratorAddress{OrchestratorAddress: args[0]}
+
+			res, err := queryClient.GetDelegateKeyByOrchestrator(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetDelegateKeysByEth() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "delegate-keys-by-eth [ethereum address]",
+		Short: "Get the validator and orchestrator an Ethereum address is registered with",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDelegateKeysByEthAddress{EthAddress: args[0]}
+
+			res, err := queryClient.GetDelegateKeyByEth(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetPendingSendToEth() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "pending-send-to-eth [bech32 sender address]",
+		Short: "Get the transfers to Ethereum that are not executed yet, of a particular sender or of all of them",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			batchesPageReq, err := readBatchesPageRequest(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPendingSendToEth{Pagination: pageReq, BatchesPagination: batchesPageReq}
+			if len(args) == 1 {
+				req.SenderAddress = args[0]
+			}
+
+			res, err := queryClient.GetPendingSendToEth(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "unbatched transfers")
+	cmd.Flags().String(flagBatchesKey, "", "pagination page-key of the batches holding transfers")
+	cmd.Flags().Uint64(flagBatchesLimit, 100, "pagination limit of the batches holding transfers")
+	return cmd
+}
+
+func CmdGetLastObservedEthereumHeight() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "last-observed-eth-height",
+		Short: "Get the last observed Ethereum block height",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LastObservedEthereumHeight(cmd.Context(), &types.QueryLastObservedEthereumHeightRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetOrchestratorVersions() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "orchestrator-versions",
+		Short: "Get the orchestrator version every validator last reported",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.OrchestratorVersions(cmd.Context(), &types.QueryOrchestratorVersionsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "orchestrator versions")
+	return cmd
+}
+
+func CmdGetBlockTimes() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "block-times",
+		Short: "Get the Cosmos and Ethereum block times used to compute batch timeouts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BlockTimes(cmd.Context(), &types.QueryBlockTimesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetBadSignatureEvidence() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "bad-signature-evidence",
+		Short: "Get the bad Ethereum signature evidence that has been processed",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BadSignatureEvidence(cmd.Context(), &types.QueryBadSignatureEvidenceRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "bad signature evidence")
+	return cmd
+}
+
+// readBatchesPageRequest reads the page of batches to return the transfers of from the flags added by
+// CmdGetPendingSendToEth, the pagination flags there page through the unbatched transfers
+func readBatchesPageRequest(cmd *cobra.Command) (*query.PageRequest, error) {
+	pageKey, err := cmd.Flags().GetString(flagBatchesKey)
+	if err != nil {
+		return nil, err
+	}
+	limit, err := cmd.Flags().GetUint64(flagBatchesLimit)
+	if err != nil {
+		return nil, err
+	}
+	//nolint: exhaustivestruct
+	return &query.PageRequest{Key: []byte(pageKey), Limit: limit}, nil
+}
+
+// addEventFilterFlags adds the flags selecting Ethereum events by nonce range and claim type
+func addEventFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64(flagStartNonce, 0, "the first event nonce to return")
+	cmd.Flags().Uint64(flagEndNonce, 0, "the last event nonce to return, 0 for no upper bound")
+	cmd.Flags().String(flagClaimType, "", "only return events of this claim type, e.g. send_to_cosmos or CLAIM_TYPE_SEND_TO_COSMOS")
+}
+
+// readEventFilterFlags reads the flags added by addEventFilterFlags
+func readEventFilterFlags(cmd *cobra.Command) (startNonce, endNonce uint64, claimType types.ClaimType, err error) {
+	if startNonce, err = cmd.Flags().GetUint64(flagStartNonce); err != nil {
+		return
+	}
+	if endNonce, err = cmd.Flags().GetUint64(flagEndNonce); err != nil {
+		return
+	}
+	name, err := cmd.Flags().GetString(flagClaimType)
+	if err != nil || name == "" {
+		return
+	}
+	value, found := types.ClaimType_value[strings.ToUpper(name)]
+	if !found {
+		value, found = types.ClaimType_value["CLAIM_TYPE_"+strings.ToUpper(name)]
+	}
+	if !found {
+		err = fmt.Errorf("unknown claim type %s", name)
+		return
+	}
+	claimType = types.ClaimType(value)
+	return
+}
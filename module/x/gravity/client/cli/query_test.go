@@ -0,0 +1,61 @@
+package cli_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/client/cli"
+)
+
+func TestQueryCmdArgs(t *testing.T) {
+	// the number of positional arguments every query command accepts
+	args := map[string][]int{
+		"params":                        {0},
+		"status":                        {0},
+		"current-valset":                {0},
+		"valset-request":                {1},
+		"valset-requests":               {0},
+		"valset-confirm":                {2},
+		"valset-confirms":               {1},
+		"pending-valset-request":        {1},
+		"pending-batch-request":         {1},
+		"pending-logic-call":            {1},
+		"last-event-nonce":              {1},
+		"batch-fees":                    {0},
+		"outgoing-batches":              {0},
+		"outgoing-batch":                {2},
+		"batch-confirms":                {2},
+		"outgoing-logic-calls":          {0},
+		"logic-confirms":                {2},
+		"erc20-to-denom":                {1},
+		"denom-to-erc20":                {1},
+		"attestations":                  {0},
+		"attestation":                   {1},
+		"observed-events":               {0},
+		"delegate-keys-by-validator":    {1},
+		"delegate-keys-by-orchestrator": {1},
+		"delegate-keys-by-eth":          {1},
+		"pending-send-to-eth":           {0, 1},
+		"last-observed-eth-height":      {0},
+		"orchestrator-versions":         {0},
+		"block-times":                   {0},
+		"bad-signature-evidence":        {0},
+	}
+
+	cmds := cli.GetQueryCmd().Commands()
+	require.Len(t, cmds, len(args))
+	for _, cmd := range cmds {
+		valid, ok := args[cmd.Name()]
+		require.True(t, ok, "unexpected command %s", cmd.Name())
+		for n := 0; n <= valid[len(valid)-1]+1; n++ {
+			err := cmd.ValidateArgs(strings.Fields(strings.Repeat("arg ", n)))
+			if n >= valid[0] && n <= valid[len(valid)-1] {
+				require.NoError(t, err, "%s with %d args", cmd.Name(), n)
+			} else {
+				require.Error(t, err, "%s with %d args", cmd.Name(), n)
+			}
+		}
+	}
+}
@@ -1,22 +1,40 @@
 package cli
 
 import (
-	"crypto/ecdsa"
+	"bufio"
+	"bytes"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
-	"log"
+	"io/ioutil"
+	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
 	"github.com/spf13/cobra"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 )
 
+const (
+	flagEthKey              = "eth-key"
+	flagEthAddress          = "eth-address"
+	flagOrchestratorVersion = "orchestrator-version"
+)
+
 func GetTxCmd(storeKey string) *cobra.Command {
 	//nolint: exhaustivestruct
 	gravityTxCmd := &cobra.Command{
@@ -29,73 +47,25 @@ func GetTxCmd(storeKey string) *cobra.Command {
 
 	gravityTxCmd.AddCommand([]*cobra.Command{
 		CmdSendToEth(),
+		CmdCancelSendToEth(),
 		CmdRequestBatch(),
 		CmdSetOrchestratorAddress(),
-		GetUnsafeTestingCmd(),
+		CmdValsetConfirm(),
+		CmdConfirmBatch(),
+		CmdConfirmLogicCall(),
+		CmdSendToCosmosClaim(),
+		CmdBatchSendToEthClaim(),
+		CmdERC20DeployedClaim(),
+		CmdLogicCallExecutedClaim(),
+		CmdValsetUpdatedClaim(),
+		CmdEthereumHeightClaim(),
+		CmdSubmitClaims(),
+		CmdSubmitBadSignatureEvidence(),
 	}...)
 
 	return gravityTxCmd
 }
 
-func GetUnsafeTestingCmd() *cobra.Command {
-	//nolint: exhaustivestruct
-	testingTxCmd := &cobra.Command{
-		Use:                        "unsafe_testing",
-		Short:                      "helpers for testing. not going into production",
-		DisableFlagParsing:         true,
-		SuggestionsMinimumDistance: 2,
-		RunE:                       client.ValidateCmd,
-	}
-	testingTxCmd.AddCommand([]*cobra.Command{
-		CmdUnsafeETHPrivKey(),
-		CmdUnsafeETHAddr(),
-	}...)
-
-	return testingTxCmd
-}
-
-func CmdUnsafeETHPrivKey() *cobra.Command {
-	//nolint: exhaustivestruct
-	return &cobra.Command{
-		Use:   "gen-eth-key",
-		Short: "Generate and print a new ecdsa key",
-		RunE: func(cmd *cobra.Command, args []string) error {
-			key, err := ethCrypto.GenerateKey()
-			if err != nil {
-				return sdkerrors.Wrap(err, "can not generate key")
-			}
-			k := "0x" + hex.EncodeToString(ethCrypto.FromECDSA(key))
-			println(k)
-			return nil
-		},
-	}
-}
-
-func CmdUnsafeETHAddr() *cobra.Command {
-	//nolint: exhaustivestruct
-	return &cobra.Command{
-		Use:   "eth-address",
-		Short: "Print address for an ECDSA eth key",
-		Args:  cobra.ExactArgs(1),
-		RunE: func(cmd *cobra.Command, args []string) error {
-			privKeyString := args[0][2:]
-			privateKey, err := ethCrypto.HexToECDSA(privKeyString)
-			if err != nil {
-				log.Fatal(err)
-			}
-			// You've got to do all this to get an Eth address from the private key
-			publicKey := privateKey.Public()
-			publicKeyECDSA, ok := publicKey.(*ecdsa.PublicKey)
-			if !ok {
-				log.Fatal("error casting public key to ECDSA")
-			}
-			ethAddress := ethCrypto.PubkeyToAddress(*publicKeyECDSA).Hex()
-			println(ethAddress)
-			return nil
-		},
-	}
-}
-
 func CmdSendToEth() *cobra.Command {
 	//nolint: exhaustivestruct
 	cmd := &cobra.Command{
@@ -172,7 +142,7 @@ func CmdRequestBatch() *cobra.Command {
 func CmdSetOrchestratorAddress() *cobra.Command {
 	//nolint: exhaustivestruct
 	cmd := &cobra.Command{
-		Use:   "set-orchestrator-address [validator-address] [orchestrator-address] [ethereum-address]",
+		Use:   "set-orchestrator-address [validator-address] [orchestrator-address] [ethereum-address-or-key-name]",
 		Short: "Allows validators to delegate their voting responsibilities to a given key.",
 		Args:  cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -180,10 +150,338 @@ func CmdSetOrchestratorAddress() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			ethAddress, err := types.ResolveEthAddress(cliCtx.Keyring, args[2])
+			if err != nil {
+				return err
+			}
 			msg := types.MsgSetOrchestratorAddress{
 				Validator:    args[0],
 				Orchestrator: args[1],
-				EthAddress:   args[2],
+				EthAddress:   ethAddress,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdSubmitUnsuspendTokenProposal submits a governance proposal lifting the suspension of a token
+func CmdSubmitUnsuspendTokenProposal() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "unsuspend-token [token_contract_address] [flags]",
+		Short: "Submit a proposal to lift the suspension of a token that timed out too many batches",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return sdkerrors.Wrap(err, "deposit")
+			}
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewUnsuspendTokenProposal(title, description, args[0])
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, cosmosAddr)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.MarkFlagRequired(govcli.FlagTitle)
+	cmd.MarkFlagRequired(govcli.FlagDescription)
+	return cmd
+}
+
+func CmdCancelSendToEth() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "cancel-send-to-eth [transaction-id]",
+		Short: "Removes an entry of the sender from the transaction pool and refunds its amount and bridge fee, if it is not in a batch yet",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			txID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "transaction id")
+			}
+
+			msg := types.NewMsgCancelSendToEth(cosmosAddr, txID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSubmitBadSignatureEvidence() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "submit-bad-signature-evidence [subject-json-file] [hex-signature]",
+		Short: "Submits evidence that a validator signed a valset, batch or logic call that never existed on this chain",
+		Long: `Submits evidence that a validator signed a valset, batch or logic call that never existed on this chain.
+The subject file holds the signed valset, batch or logic call as JSON including its type, e.g.:
+
+{"@type": "/gravity.v1.OutgoingTxBatch", "batch_nonce": "4", "batch_timeout": "1000", ...}`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var subject types.EthereumSigned
+			if err := cliCtx.JSONMarshaler.UnmarshalInterfaceJSON(bz, &subject); err != nil {
+				return sdkerrors.Wrap(err, "subject")
+			}
+			pb, ok := subject.(proto.Message)
+			if !ok {
+				return sdkerrors.Wrap(types.ErrInvalid, "subject is not a proto message")
+			}
+			any, err := codectypes.NewAnyWithValue(pb)
+			if err != nil {
+				return err
+			}
+			if _, err := hex.DecodeString(args[1]); err != nil {
+				return sdkerrors.Wrap(err, "signature")
+			}
+
+			msg := types.MsgSubmitBadSignatureEvidence{
+				Subject:   any,
+				Signature: args[1],
+				Sender:    cosmosAddr.String(),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdValsetConfirm() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "valset-confirm [nonce]",
+		Short: "Signs the valset with a particular nonce with an Ethereum key from the keystore and submits the confirmation",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+			queryClient := types.NewQueryClient(cliCtx)
+
+			nonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "nonce")
+			}
+			res, err := queryClient.ValsetRequest(cmd.Context(), &types.QueryValsetRequestRequest{Nonce: nonce})
+			if err != nil {
+				return err
+			}
+			if res.Valset == nil {
+				return sdkerrors.Wrapf(types.ErrInvalid, "no valset with nonce %d", nonce)
+			}
+
+			ethAddress, signature, err := signCheckpoint(cmd, cliCtx, res.Valset)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgValsetConfirm(nonce, ethAddress, cosmosAddr, signature)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	addEthereumKeyFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdConfirmBatch() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "confirm-batch [nonce] [token-contract]",
+		Short: "Signs the batch of a token with a particular nonce with an Ethereum key from the keystore and submits the confirmation",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+			queryClient := types.NewQueryClient(cliCtx)
+
+			nonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "nonce")
+			}
+			req := &types.QueryBatchRequestByNonceRequest{
+				Nonce:           nonce,
+				ContractAddress: args[1],
+			}
+			res, err := queryClient.BatchRequestByNonce(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			if res.Batch == nil {
+				return sdkerrors.Wrapf(types.ErrInvalid, "no batch of %s with nonce %d", args[1], nonce)
+			}
+
+			ethAddress, signature, err := signCheckpoint(cmd, cliCtx, res.Batch)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgConfirmBatch{
+				Nonce:         nonce,
+				TokenContract: res.Batch.TokenContract,
+				EthSigner:     ethAddress,
+				Orchestrator:  cosmosAddr.String(),
+				Signature:     signature,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	addEthereumKeyFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdConfirmLogicCall() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "confirm-logic-call [hex-invalidation-id] [invalidation-nonce]",
+		Short: "Signs an outgoing logic call with an Ethereum key from the keystore and submits the confirmation",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			invalidationID, err := hex.DecodeString(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalidation id")
+			}
+			invalidationNonce, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalidation nonce")
+			}
+			call, err := findOutgoingLogicCall(cmd, cliCtx, invalidationID, invalidationNonce)
+			if err != nil {
+				return err
+			}
+
+			ethAddress, signature, err := signCheckpoint(cmd, cliCtx, call)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgConfirmLogicCall{
+				InvalidationId:    hex.EncodeToString(invalidationID),
+				InvalidationNonce: invalidationNonce,
+				EthSigner:         ethAddress,
+				Orchestrator:      cosmosAddr.String(),
+				Signature:         signature,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	addEthereumKeyFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSendToCosmosClaim() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "send-to-cosmos-claim [event-nonce] [eth-block-height] [token-contract] [amount] [eth-sender] [cosmos-receiver]",
+		Short: "Claims that a deposit to the Gravity contract was observed on Ethereum",
+		Args:  cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			eventNonce, blockHeight, err := parseClaimHeader(args)
+			if err != nil {
+				return err
+			}
+			amount, ok := sdk.NewIntFromString(args[3])
+			if !ok {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "amount %s", args[3])
+			}
+
+			msg := types.MsgSendToCosmosClaim{
+				EventNonce:     eventNonce,
+				BlockHeight:    blockHeight,
+				TokenContract:  args[2],
+				Amount:         amount,
+				EthereumSender: args[4],
+				CosmosReceiver: args[5],
+				Orchestrator:   cosmosAddr.String(),
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -195,3 +493,398 @@ func CmdSetOrchestratorAddress() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdBatchSendToEthClaim() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "batch-send-to-eth-claim [event-nonce] [eth-block-height] [batch-nonce] [token-contract]",
+		Short: "Claims that a batch was executed by the Gravity contract on Ethereum",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			eventNonce, blockHeight, err := parseClaimHeader(args)
+			if err != nil {
+				return err
+			}
+			batchNonce, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "batch nonce")
+			}
+
+			msg := types.MsgBatchSendToEthClaim{
+				EventNonce:    eventNonce,
+				BlockHeight:   blockHeight,
+				BatchNonce:    batchNonce,
+				TokenContract: args[3],
+				Orchestrator:  cosmosAddr.String(),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdERC20DeployedClaim() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "erc20-deployed-claim [event-nonce] [eth-block-height] [cosmos-denom] [token-contract] [name] [symbol] [decimals]",
+		Short: "Claims that an ERC20 representing a Cosmos denom was deployed through the Gravity contract on Ethereum",
+		Args:  cobra.ExactArgs(7),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			eventNonce, blockHeight, err := parseClaimHeader(args)
+			if err != nil {
+				return err
+			}
+			decimals, err := strconv.ParseUint(args[6], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "decimals")
+			}
+
+			msg := types.MsgERC20DeployedClaim{
+				EventNonce:    eventNonce,
+				BlockHeight:   blockHeight,
+				CosmosDenom:   args[2],
+				TokenContract: args[3],
+				Name:          args[4],
+				Symbol:        args[5],
+				Decimals:      decimals,
+				Orchestrator:  cosmosAddr.String(),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdLogicCallExecutedClaim() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "logic-call-executed-claim [event-nonce] [eth-block-height] [hex-invalidation-id] [invalidation-nonce]",
+		Short: "Claims that a logic call was executed by the Gravity contract on Ethereum",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			eventNonce, blockHeight, err := parseClaimHeader(args)
+			if err != nil {
+				return err
+			}
+			invalidationID, err := hex.DecodeString(args[2])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalidation id")
+			}
+			invalidationNonce, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalidation nonce")
+			}
+
+			msg := types.MsgLogicCallExecutedClaim{
+				EventNonce:        eventNonce,
+				BlockHeight:       blockHeight,
+				InvalidationId:    invalidationID,
+				InvalidationNonce: invalidationNonce,
+				Orchestrator:      cosmosAddr.String(),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdValsetUpdatedClaim() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "valset-updated-claim [event-nonce] [eth-block-height] [valset-nonce] [members] [reward-amount] [reward-token]",
+		Short: "Claims that the Gravity contract on Ethereum updated its validator set",
+		Long: `Claims that the Gravity contract on Ethereum updated its validator set.
+Members are given as a comma separated list of ethereum-address:power pairs, e.g.:
+
+0xc783df8a850f42e7F7e57013759C285caa701eB6:2147483647,0xeAD9C93b79Ae7C1591b1FB5323BD777E86e150d4:2147483647`,
+		Args: cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			eventNonce, blockHeight, err := parseClaimHeader(args)
+			if err != nil {
+				return err
+			}
+			valsetNonce, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "valset nonce")
+			}
+			members, err := parseBridgeValidators(args[3])
+			if err != nil {
+				return err
+			}
+			rewardAmount, ok := sdk.NewIntFromString(args[4])
+			if !ok {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "reward amount %s", args[4])
+			}
+
+			msg := types.MsgValsetUpdatedClaim{
+				EventNonce:   eventNonce,
+				ValsetNonce:  valsetNonce,
+				BlockHeight:  blockHeight,
+				Members:      members,
+				RewardAmount: rewardAmount,
+				RewardToken:  args[5],
+				Orchestrator: cosmosAddr.String(),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdEthereumHeightClaim() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "ethereum-height-claim [eth-block-height]",
+		Short: "Claims that Ethereum reached a block height, allowing batches and logic calls to time out",
+		Long:  "Claims that Ethereum reached a block height, the height is rounded down and the height nonce is derived from it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			blockHeight, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "ethereum block height")
+			}
+			version, err := cmd.Flags().GetString(flagOrchestratorVersion)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgEthereumHeightClaim(blockHeight, cosmosAddr, version)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(flagOrchestratorVersion, "", "the orchestrator version to report")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSubmitClaims() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "submit-claims [claims-json-file]",
+		Short: "Submits several Ethereum event claims, ordered by event nonce, in a single message",
+		Long: `Submits several Ethereum event claims, ordered by event nonce, in a single message.
+The claims file holds a JSON array of claims including their type, e.g.:
+
+[{"@type": "/gravity.v1.MsgSendToCosmosClaim", "event_nonce": "4", ...}, {"@type": "/gravity.v1.MsgBatchSendToEthClaim", "event_nonce": "5", ...}]`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var raw []json.RawMessage
+			if err := json.Unmarshal(bz, &raw); err != nil {
+				return sdkerrors.Wrap(err, "claims")
+			}
+			claims := make([]types.EthereumClaim, len(raw))
+			for i, claimJSON := range raw {
+				if err := cliCtx.JSONMarshaler.UnmarshalInterfaceJSON(claimJSON, &claims[i]); err != nil {
+					return sdkerrors.Wrapf(err, "claim %d", i)
+				}
+			}
+
+			msg, err := types.NewMsgSubmitClaims(cosmosAddr, claims...)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// addEthereumKeyFlags adds the flags selecting the Ethereum key the confirm commands sign with
+func addEthereumKeyFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagEthKey, "", "the name of the Ethereum key in the keyring to sign with")
+	cmd.Flags().String(flagEthAddress, "", "the Ethereum key in the keystore to sign with, defaults to the Ethereum address delegated to the --from orchestrator")
+}
+
+// signCheckpoint signs the checkpoint of a valset, batch or logic call with the Ethereum key of the --eth-key flag
+// from the keyring, or otherwise from the keystore in the keyring directory, and returns the signing address and
+// hex encoded signature
+func signCheckpoint(cmd *cobra.Command, cliCtx client.Context, subject types.EthereumSigned) (string, string, error) {
+	queryClient := types.NewQueryClient(cliCtx)
+
+	params, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+	if err != nil {
+		return "", "", err
+	}
+	checkpoint := subject.GetCheckpoint(params.Params.GravityId)
+
+	ethKey, err := cmd.Flags().GetString(flagEthKey)
+	if err != nil {
+		return "", "", err
+	}
+	if ethKey != "" {
+		info, err := cliCtx.Keyring.Key(ethKey)
+		if err != nil {
+			return "", "", sdkerrors.Wrapf(err, "ethereum key %s", ethKey)
+		}
+		ethAddress, err := types.EthAddressFromKey(info)
+		if err != nil {
+			return "", "", err
+		}
+		signature, _, err := cliCtx.Keyring.Sign(ethKey, checkpoint)
+		if err != nil {
+			return "", "", err
+		}
+		return ethAddress, hex.EncodeToString(signature), nil
+	}
+
+	ethAddress, err := cmd.Flags().GetString(flagEthAddress)
+	if err != nil {
+		return "", "", err
+	}
+	if ethAddress == "" {
+		req := &types.QueryDelegateKeysByOrchestratorAddress{OrchestratorAddress: cliCtx.GetFromAddress().String()}
+		res, err := queryClient.GetDelegateKeyByOrchestrator(cmd.Context(), req)
+		if err != nil {
+			return "", "", sdkerrors.Wrap(err, "no --eth-key or --eth-address given and the orchestrator has no delegate keys")
+		}
+		ethAddress = res.EthAddress
+	}
+	if err := types.ValidateEthAddress(ethAddress); err != nil {
+		return "", "", sdkerrors.Wrap(err, "ethereum address")
+	}
+	ks := keystore.NewKeyStore(cliCtx.KeyringDir, keystore.StandardScryptN, keystore.StandardScryptP)
+	account, err := ks.Find(accounts.Account{Address: gethcommon.HexToAddress(ethAddress)})
+	if err != nil {
+		return "", "", sdkerrors.Wrapf(err, "ethereum key %s", ethAddress)
+	}
+	keyJSON, err := ioutil.ReadFile(account.URL.Path)
+	if err != nil {
+		return "", "", err
+	}
+	passphrase, err := input.GetPassword(fmt.Sprintf("Enter passphrase of ethereum key %s:", account.Address.Hex()), bufio.NewReader(cmd.InOrStdin()))
+	if err != nil && passphrase == "" {
+		return "", "", err
+	}
+	key, err := keystore.DecryptKey(keyJSON, passphrase)
+	if err != nil {
+		return "", "", sdkerrors.Wrapf(err, "ethereum key %s", ethAddress)
+	}
+
+	signature, err := types.NewEthereumSignature(checkpoint, key.PrivateKey)
+	if err != nil {
+		return "", "", err
+	}
+	return account.Address.Hex(), hex.EncodeToString(signature), nil
+}
+
+// findOutgoingLogicCall pages through the outgoing logic calls for the one with the given invalidation id and nonce
+func findOutgoingLogicCall(cmd *cobra.Command, cliCtx client.Context, invalidationID []byte, invalidationNonce uint64) (*types.OutgoingLogicCall, error) {
+	queryClient := types.NewQueryClient(cliCtx)
+
+	pageReq := &query.PageRequest{}
+	for {
+		res, err := queryClient.OutgoingLogicCalls(cmd.Context(), &types.QueryOutgoingLogicCallsRequest{Pagination: pageReq})
+		if err != nil {
+			return nil, err
+		}
+		for _, call := range res.Calls {
+			if bytes.Equal(call.InvalidationId, invalidationID) && call.InvalidationNonce == invalidationNonce {
+				return call, nil
+			}
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return nil, sdkerrors.Wrapf(types.ErrInvalid, "no outgoing logic call %x with nonce %d", invalidationID, invalidationNonce)
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+}
+
+// parseClaimHeader parses the event nonce and Ethereum block height every claim command starts with
+func parseClaimHeader(args []string) (eventNonce uint64, blockHeight uint64, err error) {
+	if eventNonce, err = strconv.ParseUint(args[0], 10, 64); err != nil {
+		return 0, 0, sdkerrors.Wrap(err, "event nonce")
+	}
+	if blockHeight, err = strconv.ParseUint(args[1], 10, 64); err != nil {
+		return 0, 0, sdkerrors.Wrap(err, "ethereum block height")
+	}
+	return eventNonce, blockHeight, nil
+}
+
+// parseBridgeValidators parses a comma separated list of ethereum-address:power pairs
+func parseBridgeValidators(s string) ([]*types.BridgeValidator, error) {
+	var members []*types.BridgeValidator
+	for _, member := range strings.Split(s, ",") {
+		parts := strings.Split(member, ":")
+		if len(parts) != 2 {
+			return nil, sdkerrors.Wrapf(types.ErrInvalid, "member %s is not an ethereum-address:power pair", member)
+		}
+		power, err := strconv.ParseUint(parts[1], 10, 64)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "power of %s", parts[0])
+		}
+		members = append(members, &types.BridgeValidator{
+			Power:           power,
+			EthereumAddress: parts[0],
+		})
+	}
+	return members, nil
+}
@@ -0,0 +1,71 @@
+package cli_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/client/cli"
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+func TestTxCmdArgs(t *testing.T) {
+	// the number of positional arguments every tx command accepts
+	args := map[string]int{
+		"send-to-eth":                   3,
+		"cancel-send-to-eth":            1,
+		"build-batch":                   1,
+		"set-orchestrator-address":      3,
+		"valset-confirm":                1,
+		"confirm-batch":                 2,
+		"confirm-logic-call":            2,
+		"send-to-cosmos-claim":          6,
+		"batch-send-to-eth-claim":       4,
+		"erc20-deployed-claim":          7,
+		"logic-call-executed-claim":     4,
+		"valset-updated-claim":          6,
+		"ethereum-height-claim":         1,
+		"submit-claims":                 1,
+		"submit-bad-signature-evidence": 2,
+	}
+
+	cmds := cli.GetTxCmd(types.StoreKey).Commands()
+	require.Len(t, cmds, len(args))
+	for _, cmd := range cmds {
+		valid, ok := args[cmd.Name()]
+		require.True(t, ok, "unexpected command %s", cmd.Name())
+		for n := 0; n <= valid+1; n++ {
+			err := cmd.ValidateArgs(strings.Fields(strings.Repeat("arg ", n)))
+			if n == valid {
+				require.NoError(t, err, "%s with %d args", cmd.Name(), n)
+			} else {
+				require.Error(t, err, "%s with %d args", cmd.Name(), n)
+			}
+		}
+		require.NotNil(t, cmd.Flags().Lookup(flags.FlagFrom), "%s has no tx flags", cmd.Name())
+	}
+}
+
+func TestConfirmCmdFlags(t *testing.T) {
+	for _, cmd := range []string{"valset-confirm", "confirm-batch", "confirm-logic-call"} {
+		c, _, err := cli.GetTxCmd(types.StoreKey).Find([]string{cmd})
+		require.NoError(t, err)
+		require.NoError(t, c.ParseFlags([]string{"--eth-key=eth", "--eth-address=0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"}))
+		ethKey, err := c.Flags().GetString("eth-key")
+		require.NoError(t, err)
+		require.Equal(t, "eth", ethKey)
+		ethAddress, err := c.Flags().GetString("eth-address")
+		require.NoError(t, err)
+		require.Equal(t, "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", ethAddress)
+	}
+
+	c, _, err := cli.GetTxCmd(types.StoreKey).Find([]string{"ethereum-height-claim"})
+	require.NoError(t, err)
+	require.NoError(t, c.ParseFlags([]string{"--orchestrator-version=v1.0.0"}))
+	version, err := c.Flags().GetString("orchestrator-version")
+	require.NoError(t, err)
+	require.Equal(t, "v1.0.0", version)
+	require.Error(t, c.ParseFlags([]string{"--eth-key=eth"}))
+}
@@ -0,0 +1,11 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/client/cli"
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/client/rest"
+)
+
+// UnsuspendTokenProposalHandler is the governance client handler for UnsuspendTokenProposal
+var UnsuspendTokenProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitUnsuspendTokenProposal, rest.UnsuspendTokenProposalRESTHandler)
@@ -8,6 +8,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	hexUtil "github.com/ethereum/go-ethereum/common/hexutil"
 	ethCrypto "github.com/ethereum/go-ethereum/crypto"
 
@@ -93,3 +95,50 @@ type bootstrapConfirmReq struct {
 	GravityID             string                 `json:"gravity_id"`
 	StartThreshold        uint64                 `json:"start_threshold"`
 }
+
+type unsuspendTokenProposalReq struct {
+	BaseReq       rest.BaseReq `json:"base_req"`
+	Title         string       `json:"title"`
+	Description   string       `json:"description"`
+	Deposit       sdk.Coins    `json:"deposit"`
+	TokenContract string       `json:"token_contract"`
+}
+
+// UnsuspendTokenProposalRESTHandler returns the REST handler for submitting an UnsuspendTokenProposal
+func UnsuspendTokenProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "unsuspend_token",
+		Handler:  unsuspendTokenProposalHandler(cliCtx),
+	}
+}
+
+func unsuspendTokenProposalHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req unsuspendTokenProposalReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(baseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewUnsuspendTokenProposal(req.Title, req.Description, req.TokenContract)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, baseReq, msg)
+	}
+}
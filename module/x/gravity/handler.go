@@ -5,6 +5,9 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/keeper"
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
@@ -56,9 +59,41 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgSubmitBadSignatureEvidence:
 			res, err := msgServer.SubmitBadSignatureEvidence(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgEthereumHeightClaim:
+			res, err := msgServer.EthereumHeightClaim(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSubmitClaims:
+			res, err := msgServer.SubmitClaims(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, fmt.Sprintf("Unrecognized Gravity Msg type: %v", msg.Type()))
 		}
 	}
 }
+
+// NewGravityProposalHandler returns a handler for Gravity governance proposals
+func NewGravityProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.UnsuspendTokenProposal:
+			return k.UnsuspendToken(ctx, c.TokenContract)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Unrecognized Gravity proposal content type: %T", c)
+		}
+	}
+}
+
+// NewBadEthSignatureEvidenceHandler returns the x/evidence handler for Gravity evidence
+func NewBadEthSignatureEvidenceHandler(k keeper.Keeper) evidencetypes.Handler {
+	return func(ctx sdk.Context, evidence exported.Evidence) error {
+		switch e := evidence.(type) {
+		case *types.BadEthSignatureEvidence:
+			return k.HandleBadEthSignatureEvidence(ctx, e)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Unrecognized Gravity evidence type: %T", e)
+		}
+	}
+}
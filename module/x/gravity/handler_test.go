@@ -2,6 +2,7 @@ package gravity
 
 import (
 	"bytes"
+	"encoding/hex"
 	"testing"
 	"time"
 
@@ -264,6 +265,214 @@ func TestMsgSendToCosmosClaimsMultiValidator(t *testing.T) {
 	assert.Equal(t, sdk.Coins{sdk.NewInt64Coin("gravity0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e", 12)}, balance3)
 }
 
+//nolint: exhaustivestruct
+func TestMsgSubmitClaims(t *testing.T) {
+	var (
+		myOrchestratorAddr sdk.AccAddress = make([]byte, sdk.AddrLen)
+		myCosmosAddr, _                   = sdk.AccAddressFromBech32("cosmos16ahjkfqxpp6lvfy9fpfnfjg39xr96qett0alj5")
+		myValAddr                         = sdk.ValAddress(myOrchestratorAddr) // revisit when proper mapping is impl in keeper
+		anyETHAddr                        = "0xf9613b532673Cc223aBa451dFA8539B87e1F666D"
+		tokenETHAddr                      = "0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e"
+		myBlockTime                       = time.Date(2020, 9, 14, 15, 20, 10, 0, time.UTC)
+		amountA, _                        = sdk.NewIntFromString("50000000000000000000")  // 50 ETH
+		amountB, _                        = sdk.NewIntFromString("100000000000000000000") // 100 ETH
+	)
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context.WithBlockTime(myBlockTime)
+	input.GravityKeeper.StakingKeeper = keeper.NewStakingKeeperMock(myValAddr)
+	input.GravityKeeper.SetOrchestratorValidator(ctx, myValAddr, myOrchestratorAddr)
+	h := NewHandler(input.GravityKeeper)
+
+	deposit := func(nonce uint64) *types.MsgSendToCosmosClaim {
+		return &types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			TokenContract:  tokenETHAddr,
+			Amount:         amountA,
+			EthereumSender: anyETHAddr,
+			CosmosReceiver: myCosmosAddr.String(),
+			Orchestrator:   myOrchestratorAddr.String(),
+		}
+	}
+	voucher := func(amount sdk.Int) sdk.Coins {
+		return sdk.Coins{sdk.NewCoin("gravity0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e", amount)}
+	}
+
+	// when both deposits are submitted in one message
+	msg, err := types.NewMsgSubmitClaims(myOrchestratorAddr, deposit(1), deposit(2))
+	require.NoError(t, err)
+	require.NoError(t, msg.ValidateBasic())
+	res, err := h(ctx, msg)
+	require.NoError(t, err)
+	EndBlocker(ctx, input.GravityKeeper)
+
+	// then each claim is attested and reported in order
+	var resp types.MsgSubmitClaimsResponse
+	require.NoError(t, resp.Unmarshal(res.Data))
+	require.Len(t, resp.Results, 2)
+	for i, r := range resp.Results {
+		assert.Equal(t, uint64(i+1), r.EventNonce)
+		assert.Equal(t, types.CLAIM_TYPE_SEND_TO_COSMOS, r.ClaimType)
+		assert.Equal(t, deposit(r.EventNonce).ClaimHash(), r.ClaimHash)
+		assert.NotNil(t, input.GravityKeeper.GetAttestation(ctx, r.EventNonce, r.ClaimHash))
+	}
+	assert.Equal(t, voucher(amountB), input.BankKeeper.GetAllBalances(ctx, myCosmosAddr))
+
+	// out of order claims are rejected before execution
+	msg, err = types.NewMsgSubmitClaims(myOrchestratorAddr, deposit(4), deposit(3))
+	require.NoError(t, err)
+	require.Error(t, msg.ValidateBasic())
+
+	// a rejected claim rejects the whole message, including the claims before it
+	msg, err = types.NewMsgSubmitClaims(myOrchestratorAddr, deposit(3), deposit(5))
+	require.NoError(t, err)
+	require.Error(t, msg.ValidateBasic())
+	_, err = h(ctx, msg)
+	require.Error(t, err)
+	EndBlocker(ctx, input.GravityKeeper)
+	assert.Nil(t, input.GravityKeeper.GetAttestation(ctx, 3, deposit(3).ClaimHash()))
+	assert.Equal(t, uint64(2), input.GravityKeeper.GetLastEventNonceByValidator(ctx, myValAddr))
+	assert.Equal(t, voucher(amountB), input.BankKeeper.GetAllBalances(ctx, myCosmosAddr))
+}
+
+//nolint: exhaustivestruct
+func TestDepositTypedEvents(t *testing.T) {
+	var (
+		myOrchestratorAddr sdk.AccAddress = make([]byte, sdk.AddrLen)
+		myCosmosAddr, _                   = sdk.AccAddressFromBech32("cosmos16ahjkfqxpp6lvfy9fpfnfjg39xr96qett0alj5")
+		myValAddr                         = sdk.ValAddress(myOrchestratorAddr) // revisit when proper mapping is impl in keeper
+		tokenETHAddr                      = "0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e"
+		amount, _                         = sdk.NewIntFromString("50000000000000000000") // 50 ETH
+	)
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context.WithEventManager(sdk.NewEventManager())
+	input.GravityKeeper.StakingKeeper = keeper.NewStakingKeeperMock(myValAddr)
+	input.GravityKeeper.SetOrchestratorValidator(ctx, myValAddr, myOrchestratorAddr)
+
+	claim := types.MsgSendToCosmosClaim{
+		EventNonce:     1,
+		TokenContract:  tokenETHAddr,
+		Amount:         amount,
+		EthereumSender: "0xf9613b532673Cc223aBa451dFA8539B87e1F666D",
+		CosmosReceiver: myCosmosAddr.String(),
+		Orchestrator:   myOrchestratorAddr.String(),
+	}
+	_, err := NewHandler(input.GravityKeeper)(ctx, &claim)
+	require.NoError(t, err)
+	EndBlocker(ctx, input.GravityKeeper)
+
+	var observed *types.EventAttestationObserved
+	var credited *types.EventDepositCredited
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		typed, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			continue
+		}
+		switch typed := typed.(type) {
+		case *types.EventAttestationObserved:
+			observed = typed
+		case *types.EventDepositCredited:
+			credited = typed
+		}
+	}
+
+	require.NotNil(t, observed)
+	assert.Equal(t, types.CLAIM_TYPE_SEND_TO_COSMOS, observed.ClaimType)
+	assert.Equal(t, hex.EncodeToString(types.GetAttestationKey(1, claim.ClaimHash())), observed.AttestationId)
+	require.NotNil(t, credited)
+	assert.Equal(t, myCosmosAddr.String(), credited.CosmosReceiver)
+	assert.Equal(t, sdk.NewCoin("gravity"+tokenETHAddr, amount), credited.Amount)
+}
+
+//nolint: exhaustivestruct
+func TestMsgEthereumHeightClaimsMultiValidator(t *testing.T) {
+	var (
+		orchestratorAddr1, _ = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		orchestratorAddr2, _ = sdk.AccAddressFromBech32("cosmos164knshrzuuurf05qxf3q5ewpfnwzl4gj4m4dfy")
+		orchestratorAddr3, _ = sdk.AccAddressFromBech32("cosmos193fw83ynn76328pty4yl7473vg9x86alq2cft7")
+		valAddr1             = sdk.ValAddress(orchestratorAddr1)
+		valAddr2             = sdk.ValAddress(orchestratorAddr2)
+		valAddr3             = sdk.ValAddress(orchestratorAddr3)
+		version              = "v0.1.0"
+	)
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	k.StakingKeeper = keeper.NewStakingKeeperMock(valAddr1, valAddr2, valAddr3)
+	k.SetOrchestratorValidator(ctx, valAddr1, orchestratorAddr1)
+	k.SetOrchestratorValidator(ctx, valAddr2, orchestratorAddr2)
+	k.SetOrchestratorValidator(ctx, valAddr3, orchestratorAddr3)
+	h := NewHandler(k)
+
+	// heights must be rounded and the nonce derived from them so that honest orchestrators agree
+	require.Error(t, (&types.MsgEthereumHeightClaim{HeightNonce: 10, BlockHeight: 105, Orchestrator: orchestratorAddr1.String()}).ValidateBasic())
+	require.Error(t, (&types.MsgEthereumHeightClaim{HeightNonce: 1, BlockHeight: 100, Orchestrator: orchestratorAddr1.String()}).ValidateBasic())
+
+	// when a single validator reports a height
+	_, err := h(ctx, types.NewMsgEthereumHeightClaim(1003, orchestratorAddr1, version))
+	require.NoError(t, err)
+	EndBlocker(ctx, k)
+	// then the height is not yet observed
+	assert.Equal(t, uint64(0), k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight)
+
+	// when a second validator independently sees a slightly later height
+	_, err = h(ctx, types.NewMsgEthereumHeightClaim(1008, orchestratorAddr2, version))
+	require.NoError(t, err)
+	EndBlocker(ctx, k)
+	// then both voted for the same claim and the height is observed without touching the event nonce
+	assert.Equal(t, uint64(1000), k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight)
+	assert.Equal(t, uint64(100), k.GetLastObservedEthereumHeightNonce(ctx))
+	assert.Equal(t, uint64(0), k.GetLastObservedEventNonce(ctx))
+
+	// a claim for an already observed height nonce is rejected
+	_, err = h(ctx, types.NewMsgEthereumHeightClaim(1009, orchestratorAddr3, version))
+	require.Error(t, err)
+
+	// the observed height never moves backwards past a height set by an observed event
+	k.SetLastObservedEthereumBlockHeight(ctx, 1015)
+	_, err = h(ctx, types.NewMsgEthereumHeightClaim(1010, orchestratorAddr1, version))
+	require.NoError(t, err)
+	_, err = h(ctx, types.NewMsgEthereumHeightClaim(1012, orchestratorAddr2, version))
+	require.NoError(t, err)
+	EndBlocker(ctx, k)
+	assert.Equal(t, uint64(101), k.GetLastObservedEthereumHeightNonce(ctx))
+	assert.Equal(t, uint64(1015), k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight)
+
+	// and attestations older than the observed one have been pruned
+	assert.Nil(t, k.GetEthereumHeightAttestation(ctx, 100, types.NewMsgEthereumHeightClaim(1000, orchestratorAddr1, version).ClaimHash()))
+
+	// a height no other validator agrees with stays pending
+	bogus := types.NewMsgEthereumHeightClaim(1_000_000_000, orchestratorAddr3, version)
+	_, err = h(ctx, bogus)
+	require.NoError(t, err)
+	EndBlocker(ctx, k)
+	require.Len(t, k.GetPendingEthereumHeightAttestations(ctx), 1)
+	_, err = h(ctx, types.NewMsgEthereumHeightClaim(1020, orchestratorAddr3, version))
+	require.Error(t, err)
+
+	// until it times out, then it is pruned and the validator may report real heights again
+	k.PruneEthereumHeightAttestations(ctx.WithBlockHeight(ctx.BlockHeight() + types.EthereumHeightClaimTimeout))
+	require.Len(t, k.GetPendingEthereumHeightAttestations(ctx), 1)
+	k.PruneEthereumHeightAttestations(ctx.WithBlockHeight(ctx.BlockHeight() + types.EthereumHeightClaimTimeout + 1))
+	assert.Nil(t, k.GetEthereumHeightAttestation(ctx, bogus.HeightNonce, bogus.ClaimHash()))
+	assert.Empty(t, k.GetPendingEthereumHeightAttestations(ctx))
+	assert.Equal(t, uint64(101), k.GetOrchestratorVersion(ctx, valAddr3).HeightNonce)
+	_, err = h(ctx, types.NewMsgEthereumHeightClaim(1020, orchestratorAddr3, version))
+	require.NoError(t, err)
+	_, err = h(ctx, types.NewMsgEthereumHeightClaim(1021, orchestratorAddr1, version))
+	require.NoError(t, err)
+	EndBlocker(ctx, k)
+	assert.Equal(t, uint64(102), k.GetLastObservedEthereumHeightNonce(ctx))
+	assert.Equal(t, uint64(1020), k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight)
+
+	// the orchestrator versions are queryable
+	res, err := k.OrchestratorVersions(sdk.WrapSDKContext(ctx), &types.QueryOrchestratorVersionsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Versions, 3)
+	for _, v := range res.Versions {
+		assert.Equal(t, version, v.Version)
+	}
+}
+
 //nolint: exhaustivestruct
 func TestMsgSetOrchestratorAddresses(t *testing.T) {
 	var (
@@ -0,0 +1,61 @@
+package gravity
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	porttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/05-port/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/keeper"
+)
+
+// TransferMiddleware wraps the IBC transfer module to complete the deposits forwarded over IBC once their packets
+// are acknowledged or time out, refunded deposits are paid to their fallback accounts
+type TransferMiddleware struct {
+	porttypes.IBCModule
+	keeper keeper.Keeper
+}
+
+var _ porttypes.IBCModule = TransferMiddleware{}
+
+// NewTransferMiddleware returns the transfer module wrapped to complete deposit forwards
+func NewTransferMiddleware(transferModule porttypes.IBCModule, k keeper.Keeper) TransferMiddleware {
+	return TransferMiddleware{
+		IBCModule: transferModule,
+		keeper:    k,
+	}
+}
+
+// OnAcknowledgementPacket lets the transfer module refund a failed packet and then pays a refunded deposit
+// forward to its fallback account
+func (m TransferMiddleware) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) (*sdk.Result, error) {
+	if _, err := m.IBCModule.OnAcknowledgementPacket(ctx, packet, acknowledgement); err != nil {
+		return nil, err
+	}
+	var ack channeltypes.Acknowledgement
+	if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
+	}
+	_, refunded := ack.Response.(*channeltypes.Acknowledgement_Error)
+	if err := m.keeper.CompleteDepositForward(ctx, packet.GetSourceChannel(), packet.GetSequence(), refunded); err != nil {
+		return nil, err
+	}
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+// OnTimeoutPacket lets the transfer module refund a timed out packet and then pays a refunded deposit forward
+// to its fallback account
+func (m TransferMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) (*sdk.Result, error) {
+	if _, err := m.IBCModule.OnTimeoutPacket(ctx, packet); err != nil {
+		return nil, err
+	}
+	if err := m.keeper.CompleteDepositForward(ctx, packet.GetSourceChannel(), packet.GetSequence(), true); err != nil {
+		return nil, err
+	}
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
@@ -0,0 +1,73 @@
+package gravity
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer"
+	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/keeper"
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+//nolint: exhaustivestruct
+func TestTransferMiddlewarePaysRefundedDepositsToFallback(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context.WithBlockTime(time.Date(2020, 9, 14, 15, 20, 10, 0, time.UTC))
+	fallback := keeper.AccAddrs[0]
+	osmoReceiver, err := bech32.ConvertAndEncode("osmo", keeper.AccAddrs[1])
+	require.NoError(t, err)
+	amount := sdk.NewInt(1000)
+	coin := sdk.NewCoin(types.GravityDenom(keeper.TokenContractAddrs[0]), amount)
+	escrowAddr := ibctransfertypes.GetEscrowAddress(ibctransfertypes.PortID, "channel-0")
+	keeper.OpenTestTransferChannel(t, input, ctx, "channel-0")
+	middleware := NewTransferMiddleware(transfer.NewAppModule(input.TransferKeeper), input.GravityKeeper)
+
+	// forward deposits a packet with the given sequence
+	forward := func(sequence uint64) channeltypes.Packet {
+		claim := &types.MsgSendToCosmosClaim{
+			EventNonce:     sequence,
+			TokenContract:  keeper.TokenContractAddrs[0],
+			Amount:         amount,
+			EthereumSender: keeper.EthAddrs[0].String(),
+			CosmosReceiver: "channel-0/" + osmoReceiver + "/" + fallback.String(),
+		}
+		require.NoError(t, input.GravityKeeper.AttestationHandler.Handle(ctx, types.Attestation{}, claim))
+		_, found := input.GravityKeeper.GetPendingDepositForward(ctx, "channel-0", sequence)
+		require.True(t, found)
+
+		data := ibctransfertypes.NewFungibleTokenPacketData(
+			coin.Denom, amount.Uint64(), input.AccountKeeper.GetModuleAddress(types.ModuleName).String(), osmoReceiver,
+		)
+		return channeltypes.NewPacket(
+			data.GetBytes(), sequence, ibctransfertypes.PortID, "channel-0", ibctransfertypes.PortID, "channel-0",
+			clienttypes.ZeroHeight(), uint64(ctx.BlockTime().Add(types.DefaultIBCForwardTimeout).UnixNano()),
+		)
+	}
+
+	// a timed out packet is refunded to the fallback
+	_, err = middleware.OnTimeoutPacket(ctx, forward(1))
+	require.NoError(t, err)
+	assert.Equal(t, sdk.Coins{coin}, input.BankKeeper.GetAllBalances(ctx, fallback))
+	assert.True(t, input.BankKeeper.GetAllBalances(ctx, escrowAddr).IsZero())
+
+	// so is a packet the counterparty failed
+	_, err = middleware.OnAcknowledgementPacket(ctx, forward(2), channeltypes.NewErrorAcknowledgement("failed").GetBytes())
+	require.NoError(t, err)
+	assert.Equal(t, sdk.Coins{coin.Add(coin)}, input.BankKeeper.GetAllBalances(ctx, fallback))
+	assert.True(t, input.BankKeeper.GetAllBalances(ctx, escrowAddr).IsZero())
+
+	// a packet the counterparty received stays sent
+	_, err = middleware.OnAcknowledgementPacket(ctx, forward(3), channeltypes.NewResultAcknowledgement([]byte{1}).GetBytes())
+	require.NoError(t, err)
+	assert.Equal(t, sdk.Coins{coin.Add(coin)}, input.BankKeeper.GetAllBalances(ctx, fallback))
+	assert.Equal(t, sdk.Coins{coin}, input.BankKeeper.GetAllBalances(ctx, escrowAddr))
+	assert.Empty(t, input.GravityKeeper.GetPendingDepositForwards(ctx))
+}
@@ -3,9 +3,11 @@ package keeper
 import (
 	"fmt"
 	"sort"
-	"strconv"
 
+	metrics "github.com/armon/go-metrics"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
@@ -22,6 +24,9 @@ func (k Keeper) Attest(
 		panic("Could not find ValAddr for delegate key, should be checked by now")
 	}
 	valAddr := val.GetOperator()
+	if claim.GetType() == types.CLAIM_TYPE_ETHEREUM_HEIGHT {
+		return k.attestEthereumHeight(ctx, valAddr, claim, anyClaim)
+	}
 	// Check that the nonce of this event is exactly one higher than the last nonce stored by this validator.
 	// We check the event nonce in processAttestation as well,
 	// but checking it here gives individual eth signers a chance to retry,
@@ -39,15 +44,17 @@ func (k Keeper) Attest(
 	// If it does not exist, create a new one.
 	if att == nil {
 		att = &types.Attestation{
-			Observed: false,
-			Votes:    []string{},
-			Height:   uint64(ctx.BlockHeight()),
-			Claim:    anyClaim,
+			Observed:    false,
+			Votes:       []string{},
+			Height:      uint64(ctx.BlockHeight()),
+			Claim:       anyClaim,
+			VoteDetails: []types.AttestationVote{},
 		}
 	}
 
 	// Add the validator's vote to this attestation
 	att.Votes = append(att.Votes, valAddr.String())
+	att.VoteDetails = append(att.VoteDetails, k.newAttestationVote(ctx, valAddr))
 
 	k.SetAttestation(ctx, claim.GetEventNonce(), claim.ClaimHash(), att)
 	k.setLastEventNonceByValidator(ctx, valAddr, claim.GetEventNonce())
@@ -57,8 +64,9 @@ func (k Keeper) Attest(
 
 // TryAttestation checks if an attestation has enough votes to be applied to the consensus state
 // and has not already been marked Observed, then calls processAttestation to actually apply it to the state,
-// and then marks it Observed and emits an event.
-func (k Keeper) TryAttestation(ctx sdk.Context, att *types.Attestation) {
+// and then marks it Observed and emits an event. The threshold is computed once per block by the caller, see
+// GetAttestationThreshold.
+func (k Keeper) TryAttestation(ctx sdk.Context, att *types.Attestation, threshold AttestationThreshold) {
 	claim, err := k.UnpackAttestationClaim(att)
 	if err != nil {
 		panic("could not cast to claim")
@@ -66,22 +74,29 @@ func (k Keeper) TryAttestation(ctx sdk.Context, att *types.Attestation) {
 	// If the attestation has not yet been Observed, sum up the votes and see if it is ready to apply to the state.
 	// This conditional stops the attestation from accidentally being applied twice.
 	if !att.Observed {
-		// Sum the current powers of all validators who have voted and see if it passes the current threshold
+		// Sum the current powers of all members of the bridge validator set who have voted and see if it passes
+		// the current threshold, the threshold is relative to the power of the members only
 		// TODO: The different integer types and math here needs a careful review
-		totalPower := k.StakingKeeper.GetLastTotalPower(ctx)
-		requiredPower := types.AttestationVotesPowerThreshold.Mul(totalPower).Quo(sdk.NewInt(100))
 		attestationPower := sdk.NewInt(0)
 		for _, validator := range att.Votes {
 			val, err := sdk.ValAddressFromBech32(validator)
 			if err != nil {
 				panic(err)
 			}
+			if threshold.Members != nil && !threshold.Members[val.String()] {
+				continue
+			}
 			validatorPower := k.StakingKeeper.GetLastValidatorPower(ctx, val)
 			// Add it to the attestation power's sum
 			attestationPower = attestationPower.Add(sdk.NewInt(validatorPower))
 			// If the power of all the validators that have voted on the attestation is higher or equal to the threshold,
 			// process the attestation, set Observed to true, and break
-			if attestationPower.GTE(requiredPower) {
+			if attestationPower.GTE(threshold.RequiredPower) {
+				// Ethereum height claims do not consume event nonces and only move the Ethereum height forward
+				if claim.GetType() == types.CLAIM_TYPE_ETHEREUM_HEIGHT {
+					k.observeEthereumHeight(ctx, att, claim)
+					break
+				}
 				lastEventNonce := k.GetLastObservedEventNonce(ctx)
 				// this check is performed at the next level up so this should never panic
 				// outside of programmer error.
@@ -89,13 +104,17 @@ func (k Keeper) TryAttestation(ctx sdk.Context, att *types.Attestation) {
 					panic("attempting to apply events to state out of order")
 				}
 				k.setLastObservedEventNonce(ctx, claim.GetEventNonce())
-				k.SetLastObservedEthereumBlockHeight(ctx, claim.GetBlockHeight())
+				// an Ethereum height claim may have already moved the observed height past this event
+				if claim.GetBlockHeight() > k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight {
+					k.SetLastObservedEthereumBlockHeight(ctx, claim.GetBlockHeight())
+				}
 
 				att.Observed = true
 				k.SetAttestation(ctx, claim.GetEventNonce(), claim.ClaimHash(), att)
 
 				k.processAttestation(ctx, att, claim)
 				k.emitObservedEvent(ctx, att, claim)
+				k.SetObservedEventRecord(ctx, types.NewObservedEventRecord(claim, uint64(ctx.BlockHeight()), attestationPower))
 
 				break
 			}
@@ -106,6 +125,41 @@ func (k Keeper) TryAttestation(ctx sdk.Context, att *types.Attestation) {
 	}
 }
 
+// AttestationThreshold is the current bridge validator set, whose votes count towards attestations, along with
+// its total power and the power an attestation needs to be observed
+type AttestationThreshold struct {
+	// Members is nil if no validator has registered keys yet, then the votes of all bonded validators count
+	Members       map[string]bool
+	TotalPower    sdk.Int
+	RequiredPower sdk.Int
+}
+
+// GetAttestationThreshold returns the current attestation threshold. Finding the bridge validator set goes
+// through all bonded validators, so callers counting the votes of several attestations compute it once.
+func (k Keeper) GetAttestationThreshold(ctx sdk.Context) (threshold AttestationThreshold) {
+	bridgeValidators, memberPower := k.GetCurrentBridgeValidators(ctx)
+	if len(bridgeValidators) == 0 {
+		threshold.TotalPower = k.StakingKeeper.GetLastTotalPower(ctx)
+	} else {
+		threshold.Members = make(map[string]bool, len(bridgeValidators))
+		for _, member := range bridgeValidators {
+			threshold.Members[member.Operator.String()] = true
+		}
+		threshold.TotalPower = sdk.NewIntFromUint64(memberPower)
+	}
+	threshold.RequiredPower = types.AttestationVotesPowerThreshold.Mul(threshold.TotalPower).Quo(sdk.NewInt(100))
+	return threshold
+}
+
+// newAttestationVote records a vote by the given validator at the current height with its current power
+func (k Keeper) newAttestationVote(ctx sdk.Context, val sdk.ValAddress) types.AttestationVote {
+	return types.AttestationVote{
+		Validator: val.String(),
+		Height:    uint64(ctx.BlockHeight()),
+		Power:     k.StakingKeeper.GetLastValidatorPower(ctx, val),
+	}
+}
+
 // processAttestation actually applies the attestation to the consensus state
 func (k Keeper) processAttestation(ctx sdk.Context, att *types.Attestation, claim types.EthereumClaim) {
 	// then execute in a new Tx so that we can store state on failure
@@ -117,30 +171,37 @@ func (k Keeper) processAttestation(ctx sdk.Context, att *types.Attestation, clai
 		k.logger(ctx).Error("attestation failed",
 			"cause", err.Error(),
 			"claim type", claim.GetType(),
-			"id", types.GetAttestationKey(claim.GetEventNonce(), claim.ClaimHash()),
+			"id", types.GetAttestationID(claim),
 			"nonce", fmt.Sprint(claim.GetEventNonce()),
 		)
+		k.EmitTypedEvent(ctx, &types.EventAttestationFailed{
+			ClaimType:     claim.GetType(),
+			AttestationId: types.GetAttestationID(claim),
+			EventNonce:    claim.GetEventNonce(),
+			Error:         err.Error(),
+		})
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, types.MetricKeyAttestationFailures},
+			1,
+			[]metrics.Label{telemetry.NewLabel(types.MetricLabelClaimType, claim.GetType().String())},
+		)
 	} else {
 		commit() // persist transient storage
+		// the cache context has its own event manager, keep the events of the applied attestation
+		ctx.EventManager().EmitEvents(xCtx.EventManager().Events())
 	}
 }
 
 // emitObservedEvent emits an event with information about an attestation that has been applied to
 // consensus state.
 func (k Keeper) emitObservedEvent(ctx sdk.Context, att *types.Attestation, claim types.EthereumClaim) {
-	observationEvent := sdk.NewEvent(
-		types.EventTypeObservation,
-		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-		sdk.NewAttribute(types.AttributeKeyAttestationType, string(claim.GetType())),
-		sdk.NewAttribute(types.AttributeKeyContract, k.GetBridgeContractAddress(ctx)),
-		sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.GetBridgeChainID(ctx)))),
-		// todo: serialize with hex/ base64 ?
-		sdk.NewAttribute(types.AttributeKeyAttestationID,
-			string(types.GetAttestationKey(claim.GetEventNonce(), claim.ClaimHash()))),
-		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(claim.GetEventNonce())),
-		// TODO: do we want to emit more information?
-	)
-	ctx.EventManager().EmitEvent(observationEvent)
+	k.EmitTypedEvent(ctx, &types.EventAttestationObserved{
+		ClaimType:      claim.GetType(),
+		AttestationId:  types.GetAttestationID(claim),
+		EventNonce:     claim.GetEventNonce(),
+		BridgeContract: k.GetBridgeContractAddress(ctx),
+		BridgeChainId:  k.GetBridgeChainID(ctx),
+	})
 }
 
 // SetAttestation sets the attestation in the store
@@ -192,6 +253,20 @@ func (k Keeper) GetAttestationMapping(ctx sdk.Context) (out map[uint64][]types.A
 	return
 }
 
+// GetAttestationsByNonce returns all the competing attestations at the given event nonce
+func (k Keeper) GetAttestationsByNonce(ctx sdk.Context, eventNonce uint64) (out []types.Attestation) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.OracleAttestationKey)
+	iter := prefixStore.Iterator(prefixRange(types.UInt64Bytes(eventNonce)))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var att types.Attestation
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &att)
+		out = append(out, att)
+	}
+	return
+}
+
 // IterateAttestaions iterates through all attestations
 func (k Keeper) IterateAttestaions(ctx sdk.Context, cb func([]byte, types.Attestation) bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -211,6 +286,7 @@ func (k Keeper) IterateAttestaions(ctx sdk.Context, cb func([]byte, types.Attest
 				XXX_unrecognized:     []byte{},
 				XXX_sizecache:        0,
 			},
+			VoteDetails: []types.AttestationVote{},
 		}
 		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &att)
 		// cb returns true to stop early
@@ -290,6 +366,7 @@ func (k Keeper) SetLastObservedEthereumBlockHeight(ctx sdk.Context, ethereumHeig
 		CosmosBlockHeight:   uint64(ctx.BlockHeight()),
 	}
 	store.Set(types.LastObservedEthereumBlockHeightKey, k.cdc.MustMarshalBinaryBare(&height))
+	k.measureEthereumBlockTime(ctx, ethereumHeight)
 }
 
 // GetLastObservedValset retrieves the last observed validator set from the store
@@ -1,18 +1,26 @@
 package keeper
 
 import (
+	"encoding/hex"
 	"fmt"
 
+	metrics "github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 )
 
 // AttestationHandler processes `observed` Attestations
 type AttestationHandler struct {
-	keeper     Keeper
-	bankKeeper types.BankKeeper
+	keeper         Keeper
+	bankKeeper     types.BankKeeper
+	transferKeeper types.TransferKeeper
+	channelKeeper  types.ChannelKeeper
 }
 
 // Handle is the entry point for Attestation processing.
@@ -22,36 +30,43 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 	case *types.MsgSendToCosmosClaim:
 		// Check if coin is Cosmos-originated asset and get denom
 		isCosmosOriginated, denom := a.keeper.ERC20ToDenomLookup(ctx, claim.TokenContract)
+		coins := sdk.Coins{sdk.NewCoin(denom, claim.Amount)}
 
-		if isCosmosOriginated {
-			// If it is cosmos originated, unlock the coins
-			coins := sdk.Coins{sdk.NewCoin(denom, claim.Amount)}
-
-			addr, err := sdk.AccAddressFromBech32(claim.CosmosReceiver)
-			if err != nil {
-				return sdkerrors.Wrap(err, "invalid receiver address")
-			}
-
-			if err = a.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
-				return sdkerrors.Wrap(err, "transfer vouchers")
-			}
-		} else {
-			// If it is not cosmos originated, mint the coins (aka vouchers)
-			coins := sdk.Coins{sdk.NewCoin(denom, claim.Amount)}
+		// Deposits to an IBC destination are forwarded from the module account, or paid to their fallback
+		addr, forward, err := types.ParseDepositReceiver(claim.CosmosReceiver)
+		if err != nil {
+			return sdkerrors.Wrap(err, "invalid receiver address")
+		}
 
+		// If it is cosmos originated the coins are unlocked from the module account, if it is not they are
+		// minted (aka vouchers) into it
+		if !isCosmosOriginated {
 			if err := a.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
 				return sdkerrors.Wrapf(err, "mint vouchers coins: %s", coins)
 			}
-
-			addr, err := sdk.AccAddressFromBech32(claim.CosmosReceiver)
-			if err != nil {
-				return sdkerrors.Wrap(err, "invalid receiver address")
-			}
-
+		}
+		if forward == nil {
 			if err = a.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
 				return sdkerrors.Wrap(err, "transfer vouchers")
 			}
 		}
+		a.keeper.EmitTypedEvent(ctx, &types.EventDepositCredited{
+			EventNonce:     claim.EventNonce,
+			TokenContract:  claim.TokenContract,
+			EthereumSender: claim.EthereumSender,
+			CosmosReceiver: claim.CosmosReceiver,
+			Amount:         sdk.NewCoin(denom, claim.Amount),
+		})
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, types.MetricKeyDeposits},
+			1,
+			[]metrics.Label{telemetry.NewLabel(types.MetricLabelTokenContract, claim.TokenContract)},
+		)
+		if forward != nil {
+			if err := a.forwardDeposit(ctx, claim.EventNonce, addr, *forward, sdk.NewCoin(denom, claim.Amount)); err != nil {
+				return err
+			}
+		}
 	// withdraw in this context means a withdraw from the Ethereum side of the bridge
 	case *types.MsgBatchSendToEthClaim:
 		a.keeper.OutgoingTxBatchExecuted(ctx, claim.TokenContract, claim.BatchNonce)
@@ -113,6 +128,26 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 
 		// Add to denom-erc20 mapping
 		a.keeper.setCosmosOriginatedDenomToERC20(ctx, claim.CosmosDenom, claim.TokenContract)
+		a.keeper.EmitTypedEvent(ctx, &types.EventERC20Deployed{
+			EventNonce:    claim.EventNonce,
+			CosmosDenom:   claim.CosmosDenom,
+			TokenContract: claim.TokenContract,
+			Name:          claim.Name,
+			Symbol:        claim.Symbol,
+			Decimals:      claim.Decimals,
+		})
+	case *types.MsgLogicCallExecutedClaim:
+		// the logic call is done, drop it so that it is neither signed nor timed out anymore. It may already be
+		// gone if it timed out on Cosmos before the execution was observed, its confirms are then already scheduled
+		// for pruning
+		if a.keeper.HasOutgoingLogicCall(ctx, claim.InvalidationId, claim.InvalidationNonce) {
+			a.keeper.DeleteOutgoingLogicCall(ctx, claim.InvalidationId, claim.InvalidationNonce)
+		}
+		a.keeper.EmitTypedEvent(ctx, &types.EventOutgoingLogicCallExecuted{
+			InvalidationId:    hex.EncodeToString(claim.InvalidationId),
+			InvalidationNonce: claim.InvalidationNonce,
+			EventNonce:        claim.EventNonce,
+		})
 	case *types.MsgValsetUpdatedClaim:
 		// TODO here we should check the contents of the validator set against
 		// the store, if they differ we should take some action to indicate to the
@@ -166,3 +201,71 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 	}
 	return nil
 }
+
+// forwardDeposit sends a credited deposit on over IBC from the gravity module account. If the transfer can't be
+// sent the deposit is paid to the fallback account right away, otherwise it is recorded as pending until its
+// packet is acknowledged and paid to the fallback account if the packet fails or times out, see
+// Keeper.CompleteDepositForward.
+func (a AttestationHandler) forwardDeposit(ctx sdk.Context, eventNonce uint64, fallback sdk.AccAddress, forward types.IBCForward, amount sdk.Coin) error {
+	// only keep the state changes of the transfer if it was sent
+	cacheCtx, commit := ctx.CacheContext()
+	sequence, found := a.channelKeeper.GetNextSequenceSend(cacheCtx, ibctransfertypes.PortID, forward.Channel)
+	err := sdkerrors.Wrapf(types.ErrInvalid, "no send sequence for channel %s", forward.Channel)
+	if found {
+		timeout := ctx.BlockTime().Add(forward.Timeout)
+		err = a.transferKeeper.SendTransfer(
+			cacheCtx,
+			ibctransfertypes.PortID,
+			forward.Channel,
+			amount,
+			authtypes.NewModuleAddress(types.ModuleName),
+			forward.Receiver,
+			clienttypes.ZeroHeight(),
+			uint64(timeout.UnixNano()),
+		)
+	}
+	if err == nil {
+		commit()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		a.keeper.SetPendingDepositForward(ctx, types.PendingDepositForward{
+			Channel:    forward.Channel,
+			Sequence:   sequence,
+			EventNonce: eventNonce,
+			Fallback:   fallback.String(),
+			Amount:     amount,
+		})
+		a.keeper.EmitTypedEvent(ctx, &types.EventDepositForwarded{
+			EventNonce: eventNonce,
+			Channel:    forward.Channel,
+			Receiver:   forward.Receiver,
+			Fallback:   fallback.String(),
+			Amount:     amount,
+			Sequence:   sequence,
+		})
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, types.MetricKeyDepositsForwarded},
+			1,
+			[]metrics.Label{telemetry.NewLabel(types.MetricLabelChannel, forward.Channel)},
+		)
+		return nil
+	}
+
+	if err := a.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, fallback, sdk.Coins{amount}); err != nil {
+		return sdkerrors.Wrap(err, "transfer vouchers")
+	}
+	a.keeper.logger(ctx).Info("deposit forward failed, paid to fallback account",
+		"cause", err.Error(),
+		"nonce", fmt.Sprint(eventNonce),
+		"channel", forward.Channel,
+		"fallback", fallback.String(),
+	)
+	a.keeper.EmitTypedEvent(ctx, &types.EventDepositForwardFailed{
+		EventNonce: eventNonce,
+		Channel:    forward.Channel,
+		Receiver:   forward.Receiver,
+		Fallback:   fallback.String(),
+		Amount:     amount,
+		Error:      err.Error(),
+	})
+	return nil
+}
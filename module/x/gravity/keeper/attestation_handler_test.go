@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+//nolint: exhaustivestruct
+func TestDepositForwardedOverIBC(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context.WithBlockTime(time.Date(2020, 9, 14, 15, 20, 10, 0, time.UTC))
+	k := input.GravityKeeper
+	fallback := AccAddrs[0]
+	osmoReceiver, err := bech32.ConvertAndEncode("osmo", AccAddrs[1])
+	require.NoError(t, err)
+	amount := sdk.NewInt(1000)
+	coin := sdk.NewCoin(types.GravityDenom(TokenContractAddrs[0]), amount)
+	moduleAddr := input.AccountKeeper.GetModuleAddress(types.ModuleName)
+	escrowAddr := ibctransfertypes.GetEscrowAddress(ibctransfertypes.PortID, "channel-0")
+	OpenTestTransferChannel(t, input, ctx, "channel-0")
+
+	deposit := func(nonce uint64, receiver string) {
+		claim := &types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			TokenContract:  TokenContractAddrs[0],
+			Amount:         amount,
+			EthereumSender: EthAddrs[0].String(),
+			CosmosReceiver: receiver,
+		}
+		require.NoError(t, k.AttestationHandler.Handle(ctx, types.Attestation{}, claim))
+	}
+
+	// the deposit is sent on from the module account and kept as pending until its packet is acknowledged
+	deposit(1, "channel-0/"+osmoReceiver+"/"+fallback.String()+"/600")
+	assert.Equal(t, sdk.Coins{coin}, input.BankKeeper.GetAllBalances(ctx, escrowAddr))
+	assert.True(t, input.BankKeeper.GetAllBalances(ctx, fallback).IsZero())
+	assert.True(t, input.BankKeeper.GetAllBalances(ctx, moduleAddr).IsZero())
+	commitment := input.IBCKeeper.ChannelKeeper.GetPacketCommitment(ctx, ibctransfertypes.PortID, "channel-0", 1)
+	assert.NotEmpty(t, commitment)
+	forward, found := k.GetPendingDepositForward(ctx, "channel-0", 1)
+	require.True(t, found)
+	assert.Equal(t, types.PendingDepositForward{
+		Channel:    "channel-0",
+		Sequence:   1,
+		EventNonce: 1,
+		Fallback:   fallback.String(),
+		Amount:     coin,
+	}, forward)
+	assert.True(t, hasEvent(ctx, "gravity.v1.EventDepositForwarded"))
+	assert.True(t, hasEvent(ctx, "send_packet"))
+
+	// a successful acknowledgement completes the forward
+	require.NoError(t, k.CompleteDepositForward(ctx, "channel-0", 1, false))
+	_, found = k.GetPendingDepositForward(ctx, "channel-0", 1)
+	assert.False(t, found)
+	assert.True(t, input.BankKeeper.GetAllBalances(ctx, fallback).IsZero())
+
+	// a refunded packet pays the deposit to the fallback account once the transfer module refunded it
+	deposit(2, "channel-0/"+osmoReceiver+"/"+fallback.String())
+	_, found = k.GetPendingDepositForward(ctx, "channel-0", 2)
+	require.True(t, found)
+	require.NoError(t, input.BankKeeper.SendCoins(ctx, escrowAddr, moduleAddr, sdk.Coins{coin}))
+	require.NoError(t, k.CompleteDepositForward(ctx, "channel-0", 2, true))
+	assert.Equal(t, sdk.Coins{coin}, input.BankKeeper.GetAllBalances(ctx, fallback))
+	assert.True(t, hasEvent(ctx, "gravity.v1.EventDepositForwardRefunded"))
+	_, found = k.GetPendingDepositForward(ctx, "channel-0", 2)
+	assert.False(t, found)
+
+	// packets that don't forward a deposit are left alone
+	require.NoError(t, k.CompleteDepositForward(ctx, "channel-0", 2, true))
+	assert.Equal(t, sdk.Coins{coin}, input.BankKeeper.GetAllBalances(ctx, fallback))
+
+	// a transfer that can't be sent pays the deposit to the fallback account and leaves no state behind
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	deposit(3, "channel-1/"+osmoReceiver+"/"+fallback.String())
+	assert.Equal(t, sdk.Coins{coin.Add(coin)}, input.BankKeeper.GetAllBalances(ctx, fallback))
+	assert.True(t, input.BankKeeper.GetAllBalances(ctx, moduleAddr).IsZero())
+	assert.True(t, hasEvent(ctx, "gravity.v1.EventDepositForwardFailed"))
+	assert.False(t, hasEvent(ctx, "gravity.v1.EventDepositForwarded"))
+	assert.Empty(t, k.GetPendingDepositForwards(ctx))
+}
+
+//nolint: exhaustivestruct
+func TestLogicCallExecuted(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	params := k.GetParams(ctx)
+	invalidationID := []byte("invalidation id")
+
+	confirm := func(nonce uint64) {
+		k.SetLogicCallConfirm(ctx, &types.MsgConfirmLogicCall{
+			InvalidationId:    hex.EncodeToString(invalidationID),
+			InvalidationNonce: nonce,
+			EthSigner:         EthAddrs[0].String(),
+			Orchestrator:      AccAddrs[0].String(),
+			Signature:         "signature",
+		})
+	}
+	executed := func(nonce uint64) {
+		claim := &types.MsgLogicCallExecutedClaim{
+			EventNonce:        nonce,
+			InvalidationId:    invalidationID,
+			InvalidationNonce: nonce,
+		}
+		require.NoError(t, k.AttestationHandler.Handle(ctx, types.Attestation{}, claim))
+	}
+
+	// an executed logic call is deleted, its confirms are kept for the slashing window
+	k.SetOutgoingLogicCall(ctx, &types.OutgoingLogicCall{InvalidationId: invalidationID, InvalidationNonce: 1, Block: uint64(ctx.BlockHeight())})
+	confirm(1)
+	executed(1)
+	assert.False(t, k.HasOutgoingLogicCall(ctx, invalidationID, 1))
+	assert.True(t, hasEvent(ctx, "gravity.v1.EventOutgoingLogicCallExecuted"))
+	k.PruneConfirms(ctx)
+	assert.Len(t, k.GetLogicConfirmByInvalidationIDAndNonce(ctx, invalidationID, 1), 1)
+
+	// a logic call that is already gone is left alone and nothing is scheduled for pruning
+	confirm(2)
+	executed(2)
+	assert.False(t, k.HasOutgoingLogicCall(ctx, invalidationID, 2))
+	assert.Nil(t, ctx.KVStore(k.storeKey).Get(types.GetPendingConfirmPruneKey(
+		params.SignedLogicCallsWindow, types.GetLogicConfirmKey(invalidationID, 2, nil))))
+	k.PruneConfirms(ctx)
+	assert.Len(t, k.GetLogicConfirmByInvalidationIDAndNonce(ctx, invalidationID, 2), 1)
+}
+
+func hasEvent(ctx sdk.Context, eventType string) bool {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
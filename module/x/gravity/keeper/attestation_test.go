@@ -6,6 +6,7 @@ import (
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
 )
 
@@ -49,3 +50,161 @@ func TestGetMostRecentAttestations(t *testing.T) {
 			"The %vth claim does not match our message: claim %v\n message %v", n, attest.Claim, msgs[n])
 	}
 }
+
+func TestGetAttestationsQuery(t *testing.T) {
+	input := CreateTestEnv(t)
+	k := input.GravityKeeper
+	ctx := input.Context
+
+	for nonce := uint64(1); nonce <= 10; nonce++ {
+		msg := types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			BlockHeight:    1,
+			TokenContract:  "0x00000000000000000001",
+			Amount:         sdktypes.NewInt(10000000000),
+			EthereumSender: "0x00000000000000000002",
+			CosmosReceiver: "0x00000000000000000003",
+			Orchestrator:   "0x00000000000000000004",
+		}
+		any, err := codectypes.NewAnyWithValue(&msg)
+		require.NoError(t, err)
+		k.SetAttestation(ctx, nonce, msg.ClaimHash(), &types.Attestation{Height: uint64(ctx.BlockHeight()), Claim: any})
+	}
+	c := sdktypes.WrapSDKContext(ctx)
+
+	// the legacy limit still applies without pagination
+	res, err := k.GetAttestations(c, &types.QueryAttestationsRequest{Limit: 3})
+	require.NoError(t, err)
+	require.Len(t, res.Attestations, 3)
+
+	// the nonce range is paged through
+	res, err = k.GetAttestations(c, &types.QueryAttestationsRequest{StartNonce: 4, EndNonce: 8, Pagination: &query.PageRequest{Limit: 3}})
+	require.NoError(t, err)
+	require.Len(t, res.Attestations, 3)
+	first, err := k.UnpackAttestationClaim(res.Attestations[0])
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), first.GetEventNonce())
+	res, err = k.GetAttestations(c, &types.QueryAttestationsRequest{StartNonce: 4, EndNonce: 8, Pagination: &query.PageRequest{Key: res.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Len(t, res.Attestations, 2)
+
+	res, err = k.GetAttestations(c, &types.QueryAttestationsRequest{ClaimType: types.CLAIM_TYPE_BATCH_SEND_TO_ETH})
+	require.NoError(t, err)
+	require.Empty(t, res.Attestations)
+}
+
+func TestObservedEventArchive(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	msg := types.MsgSendToCosmosClaim{
+		EventNonce:     1,
+		BlockHeight:    500,
+		TokenContract:  "0x00000000000000000001",
+		Amount:         sdktypes.NewInt(1000),
+		EthereumSender: "0x00000000000000000002",
+		CosmosReceiver: AccAddrs[0].String(),
+		Orchestrator:   AccAddrs[0].String(),
+	}
+	any, err := codectypes.NewAnyWithValue(&msg)
+	require.NoError(t, err)
+	att := &types.Attestation{
+		Observed: false,
+		Votes:    []string{ValAddrs[0].String(), ValAddrs[1].String(), ValAddrs[2].String(), ValAddrs[3].String()},
+		Height:   uint64(ctx.BlockHeight()),
+		Claim:    any,
+	}
+	k.SetAttestation(ctx, msg.EventNonce, msg.ClaimHash(), att)
+	k.TryAttestation(ctx, att, k.GetAttestationThreshold(ctx))
+	require.True(t, att.Observed)
+
+	// the event is archived as it is observed
+	record, found := k.GetObservedEventRecord(ctx, msg.EventNonce)
+	require.True(t, found)
+	require.Equal(t, types.CLAIM_TYPE_SEND_TO_COSMOS, record.ClaimType)
+	require.Equal(t, msg.ClaimHash(), record.ClaimHash)
+	require.Equal(t, uint64(500), record.EthereumHeight)
+	require.Equal(t, uint64(ctx.BlockHeight()), record.CosmosHeight)
+	require.True(t, record.ObservedPower.IsPositive())
+	require.Equal(t, msg.TokenContract, record.TokenContract)
+	require.Equal(t, msg.Amount, record.Amount)
+	require.Equal(t, msg.CosmosReceiver, record.Receiver)
+
+	for nonce := uint64(2); nonce <= 5; nonce++ {
+		claim := &types.MsgBatchSendToEthClaim{EventNonce: nonce, BlockHeight: 500 + nonce, BatchNonce: nonce, TokenContract: msg.TokenContract}
+		k.SetObservedEventRecord(ctx, types.NewObservedEventRecord(claim, uint64(ctx.BlockHeight()), sdktypes.NewInt(1)))
+	}
+
+	// events can be queried by nonce range and claim type
+	res, err := k.ObservedEvents(sdktypes.WrapSDKContext(ctx), &types.QueryObservedEventsRequest{
+		StartNonce: 1,
+		EndNonce:   4,
+		ClaimType:  types.CLAIM_TYPE_BATCH_SEND_TO_ETH,
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Records, 2)
+	require.Equal(t, uint64(2), res.Records[0].EventNonce)
+	require.Equal(t, uint64(3), res.Records[1].EventNonce)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	res, err = k.ObservedEvents(sdktypes.WrapSDKContext(ctx), &types.QueryObservedEventsRequest{
+		StartNonce: 1,
+		EndNonce:   4,
+		ClaimType:  types.CLAIM_TYPE_BATCH_SEND_TO_ETH,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Records, 1)
+	require.Equal(t, uint64(4), res.Records[0].EventNonce)
+	require.Equal(t, uint64(4), res.Records[0].Nonce)
+}
+
+func TestAttestationDetail(t *testing.T) {
+	input, ctx := SetupTestChain(t, 4)
+	k := input.GravityKeeper
+	validators := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	require.Len(t, validators, 4)
+
+	// two validators claim one deposit, a third claims a different one and the last does not vote
+	claims := []*types.MsgSendToCosmosClaim{}
+	for i, amount := range []int64{1000, 1000, 2000} {
+		val := validators[i].GetOperator()
+		claim := &types.MsgSendToCosmosClaim{
+			EventNonce:     1,
+			BlockHeight:    500,
+			TokenContract:  "0x0bc529c00C6401aEF6D220BE8C6Ea1667F6Ad93e",
+			Amount:         sdktypes.NewInt(amount),
+			EthereumSender: EthAddrs[0].String(),
+			CosmosReceiver: AccAddrs[0].String(),
+			Orchestrator:   sdktypes.AccAddress(val).String(),
+		}
+		any, err := codectypes.NewAnyWithValue(claim)
+		require.NoError(t, err)
+		_, err = k.Attest(ctx, claim, any)
+		require.NoError(t, err)
+		claims = append(claims, claim)
+	}
+
+	res, err := k.AttestationDetail(sdktypes.WrapSDKContext(ctx), &types.QueryAttestationDetailRequest{EventNonce: 1})
+	require.NoError(t, err)
+	require.Len(t, res.Claims, 2)
+	power := k.StakingKeeper.GetLastValidatorPower(ctx, validators[0].GetOperator())
+	for _, detail := range res.Claims {
+		expVoters := 1
+		if string(detail.ClaimHash) == string(claims[0].ClaimHash()) {
+			expVoters = 2
+		}
+		require.Len(t, detail.Votes, expVoters)
+		require.False(t, detail.Observed)
+		require.Equal(t, power*int64(expVoters), detail.VotePower)
+		require.Equal(t, power*int64(expVoters), detail.CountedPower)
+		for _, vote := range detail.Votes {
+			require.Equal(t, uint64(ctx.BlockHeight()), vote.Height)
+			require.Equal(t, power, vote.Power)
+		}
+	}
+	require.Equal(t, []string{validators[3].GetOperator().String()}, res.MissingValidators)
+	require.Equal(t, sdktypes.NewInt(4*power), res.TotalPower)
+	require.Equal(t, sdktypes.NewInt(4*power*66/100), res.RequiredPower)
+}
@@ -1,8 +1,8 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
-	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -27,6 +27,9 @@ func (k Keeper) BuildOutgoingTXBatch(
 	if maxElements == 0 {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "max elements value")
 	}
+	if k.IsTokenSuspended(ctx, contractAddress) {
+		return nil, sdkerrors.Wrap(types.ErrTokenSuspended, contractAddress)
+	}
 
 	lastBatch := k.GetLastOutgoingBatchByTokenType(ctx, contractAddress)
 
@@ -61,18 +64,20 @@ func (k Keeper) BuildOutgoingTXBatch(
 	k.StoreBatch(ctx, batch)
 
 	// Get the checkpoint and store it as a legit past batch
-	checkpoint := batch.GetCheckpoint(k.GetGravityID(ctx))
-	k.SetPastEthSignatureCheckpoint(ctx, checkpoint)
-
-	batchEvent := sdk.NewEvent(
-		types.EventTypeOutgoingBatch,
-		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-		sdk.NewAttribute(types.AttributeKeyContract, k.GetBridgeContractAddress(ctx)),
-		sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.GetBridgeChainID(ctx)))),
-		sdk.NewAttribute(types.AttributeKeyOutgoingBatchID, fmt.Sprint(nextID)),
-		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(nextID)),
-	)
-	ctx.EventManager().EmitEvent(batchEvent)
+	k.SetPastEthSignatureCheckpoint(ctx, batch)
+
+	txIds := make([]uint64, len(selectedTx))
+	for i, tx := range selectedTx {
+		txIds[i] = tx.Id
+	}
+	k.EmitTypedEvent(ctx, &types.EventOutgoingBatchCreated{
+		BatchNonce:     batch.BatchNonce,
+		TokenContract:  batch.TokenContract,
+		TxIds:          txIds,
+		BatchTimeout:   batch.BatchTimeout,
+		BridgeContract: k.GetBridgeContractAddress(ctx),
+		BridgeChainId:  k.GetBridgeChainID(ctx),
+	})
 	return batch, nil
 }
 
@@ -86,13 +91,17 @@ func (k Keeper) getBatchTimeoutHeight(ctx sdk.Context) uint64 {
 	if heights.CosmosBlockHeight == 0 || heights.EthereumBlockHeight == 0 {
 		return 0
 	}
+	// the measured block times are used where available, falling back to the AverageBlockTime and
+	// AverageEthereumBlockTime params
+	cosmosBlockTime := k.GetCosmosBlockTime(ctx)
+	ethereumBlockTime := k.GetEthereumBlockTime(ctx)
 	// we project how long it has been in milliseconds since the last Ethereum block height was observed
-	projectedMillis := (uint64(currentCosmosHeight) - heights.CosmosBlockHeight) * params.AverageBlockTime
+	projectedMillis := (uint64(currentCosmosHeight) - heights.CosmosBlockHeight) * cosmosBlockTime
 	// we convert that projection into the current Ethereum height using the average Ethereum block time in millis
-	projectedCurrentEthereumHeight := (projectedMillis / params.AverageEthereumBlockTime) + heights.EthereumBlockHeight
+	projectedCurrentEthereumHeight := (projectedMillis / ethereumBlockTime) + heights.EthereumBlockHeight
 	// we convert our target time for block timeouts (lets say 12 hours) into a number of blocks to
 	// place on top of our projection of the current Ethereum block height.
-	blocksToAdd := params.TargetBatchTimeout / params.AverageEthereumBlockTime
+	blocksToAdd := params.TargetBatchTimeout / ethereumBlockTime
 	return projectedCurrentEthereumHeight + blocksToAdd
 }
 
@@ -120,6 +129,15 @@ func (k Keeper) OutgoingTxBatchExecuted(ctx sdk.Context, tokenContract string, n
 	// Delete batch since it is finished
 	k.DeleteBatch(ctx, *b)
 
+	// the token is evidently transferable, so the timeout streak is broken
+	k.resetConsecutiveBatchTimeouts(ctx, tokenContract)
+
+	k.EmitTypedEvent(ctx, &types.EventOutgoingBatchExecuted{
+		BatchNonce:     nonce,
+		TokenContract:  tokenContract,
+		BridgeContract: k.GetBridgeContractAddress(ctx),
+		BridgeChainId:  k.GetBridgeChainID(ctx),
+	})
 }
 
 // StoreBatch stores a transaction batch
@@ -144,9 +162,11 @@ func (k Keeper) StoreBatchUnsafe(ctx sdk.Context, batch *types.OutgoingTxBatch)
 	store.Set(blockKey, k.cdc.MustMarshalBinaryBare(batch))
 }
 
-// DeleteBatch deletes an outgoing transaction batch
+// DeleteBatch deletes an outgoing transaction batch, its confirms are pruned once the SignedBatchesWindow has passed
 func (k Keeper) DeleteBatch(ctx sdk.Context, batch types.OutgoingTxBatch) {
 	store := ctx.KVStore(k.storeKey)
+	k.scheduleConfirmPruning(ctx, batch.Block+k.GetParams(ctx).SignedBatchesWindow,
+		types.GetBatchConfirmKey(batch.TokenContract, batch.BatchNonce, nil))
 	store.Delete(types.GetOutgoingTxBatchKey(batch.TokenContract, batch.BatchNonce))
 	store.Delete(types.GetOutgoingTxBatchBlockKey(batch.Block))
 }
@@ -207,15 +227,12 @@ func (k Keeper) CancelOutgoingTXBatch(ctx sdk.Context, tokenContract string, non
 	// Delete batch since it is finished
 	k.DeleteBatch(ctx, *batch)
 
-	batchEvent := sdk.NewEvent(
-		types.EventTypeOutgoingBatchCanceled,
-		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-		sdk.NewAttribute(types.AttributeKeyContract, k.GetBridgeContractAddress(ctx)),
-		sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.GetBridgeChainID(ctx)))),
-		sdk.NewAttribute(types.AttributeKeyOutgoingBatchID, fmt.Sprint(nonce)),
-		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(nonce)),
-	)
-	ctx.EventManager().EmitEvent(batchEvent)
+	k.EmitTypedEvent(ctx, &types.EventOutgoingBatchCanceled{
+		BatchNonce:     nonce,
+		TokenContract:  tokenContract,
+		BridgeContract: k.GetBridgeContractAddress(ctx),
+		BridgeChainId:  k.GetBridgeChainID(ctx),
+	})
 	return nil
 }
 
@@ -274,16 +291,22 @@ func (k Keeper) GetLastSlashedBatchBlock(ctx sdk.Context) uint64 {
 	return types.UInt64FromBytes(bytes)
 }
 
-// GetUnSlashedBatches returns all the unslashed batches in state
-func (k Keeper) GetUnSlashedBatches(ctx sdk.Context, maxHeight uint64) (out []*types.OutgoingTxBatch) {
+// GetUnSlashedBatches returns the unslashed batches in state created before maxHeight in ASC order of block.
+// Slashing progress is tracked by block, so once limit is reached the remaining batches created in the same
+// block as the last one are still included.
+func (k Keeper) GetUnSlashedBatches(ctx sdk.Context, maxHeight uint64, limit uint64) (out []*types.OutgoingTxBatch) {
 	lastSlashedBatchBlock := k.GetLastSlashedBatchBlock(ctx)
 	k.IterateBatchBySlashedBatchBlock(ctx,
 		lastSlashedBatchBlock,
 		maxHeight,
 		func(_ []byte, batch *types.OutgoingTxBatch) bool {
-			if batch.Block > lastSlashedBatchBlock {
-				out = append(out, batch)
+			if batch.Block <= lastSlashedBatchBlock {
+				return false
 			}
+			if uint64(len(out)) >= limit && out[len(out)-1].Block != batch.Block {
+				return true
+			}
+			out = append(out, batch)
 			return false
 		})
 	return
@@ -308,3 +331,99 @@ func (k Keeper) IterateBatchBySlashedBatchBlock(
 		}
 	}
 }
+
+// GetConsecutiveBatchTimeouts returns the number of batches in a row that have timed out for the given token
+func (k Keeper) GetConsecutiveBatchTimeouts(ctx sdk.Context, tokenContract string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bytes := store.Get(types.GetConsecutiveBatchTimeoutsKey(tokenContract))
+
+	if len(bytes) == 0 {
+		return 0
+	}
+	return types.UInt64FromBytes(bytes)
+}
+
+// resetConsecutiveBatchTimeouts clears the timeout streak for the given token
+func (k Keeper) resetConsecutiveBatchTimeouts(ctx sdk.Context, tokenContract string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetConsecutiveBatchTimeoutsKey(tokenContract))
+}
+
+// RecordBatchTimeout is called once a batch for the given token has timed out and been returned to the pool.
+// Tokens that revert every transfer (paused, blacklisted destinations) will otherwise be batched and timed
+// out forever, so once BatchTimeoutSuspensionThreshold batches in a row have timed out the token is suspended.
+// Batches built together usually time out together, so at most one timeout is counted per block
+func (k Keeper) RecordBatchTimeout(ctx sdk.Context, tokenContract string) {
+	// batches that were already in flight when the token was suspended are refunded as they time out
+	if k.IsTokenSuspended(ctx, tokenContract) {
+		k.refundUnbatchedTransactionsByContract(ctx, tokenContract)
+		return
+	}
+
+	height := types.UInt64Bytes(uint64(ctx.BlockHeight()))
+	tStore := ctx.TransientStore(k.tStoreKey)
+	if bytes.Equal(tStore.Get(types.GetBatchTimeoutHeightKey(tokenContract)), height) {
+		return
+	}
+	tStore.Set(types.GetBatchTimeoutHeightKey(tokenContract), height)
+
+	timeouts := k.GetConsecutiveBatchTimeouts(ctx, tokenContract) + 1
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetConsecutiveBatchTimeoutsKey(tokenContract), types.UInt64Bytes(timeouts))
+
+	threshold := k.GetParams(ctx).BatchTimeoutSuspensionThreshold
+	if threshold != 0 && timeouts >= threshold {
+		k.SuspendToken(ctx, tokenContract)
+	}
+}
+
+// IsTokenSuspended returns true if the given token contract has been suspended
+func (k Keeper) IsTokenSuspended(ctx sdk.Context, tokenContract string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetSuspendedTokenKey(tokenContract))
+}
+
+// SuspendToken marks the given token contract as suspended and refunds every unbatched transaction
+// for it. Batches that are still in flight are left alone, they may yet execute on Ethereum so they
+// are only refunded once they time out
+func (k Keeper) SuspendToken(ctx sdk.Context, tokenContract string) {
+	k.setTokenSuspended(ctx, tokenContract)
+
+	k.refundUnbatchedTransactionsByContract(ctx, tokenContract)
+
+	k.EmitTypedEvent(ctx, &types.EventTokenSuspended{
+		TokenContract: tokenContract,
+		BatchTimeouts: k.GetConsecutiveBatchTimeouts(ctx, tokenContract),
+	})
+}
+
+// setTokenSuspended marks the given token contract as suspended without touching the pool
+func (k Keeper) setTokenSuspended(ctx sdk.Context, tokenContract string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetSuspendedTokenKey(tokenContract), []byte{0x1})
+}
+
+// UnsuspendToken lifts the suspension of the given token contract and resets its timeout streak,
+// this is only reachable through a governance proposal
+func (k Keeper) UnsuspendToken(ctx sdk.Context, tokenContract string) error {
+	if !k.IsTokenSuspended(ctx, tokenContract) {
+		return sdkerrors.Wrapf(types.ErrInvalid, "token %s is not suspended", tokenContract)
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetSuspendedTokenKey(tokenContract))
+	k.resetConsecutiveBatchTimeouts(ctx, tokenContract)
+
+	k.EmitTypedEvent(ctx, &types.EventTokenUnsuspended{TokenContract: tokenContract})
+	return nil
+}
+
+// GetSuspendedTokens returns all suspended token contracts
+func (k Keeper) GetSuspendedTokens(ctx sdk.Context) (out []string) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SuspendedTokenKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		out = append(out, string(iter.Key()))
+	}
+	return
+}
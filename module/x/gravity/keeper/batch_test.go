@@ -518,3 +518,48 @@ func TestPoolTxRefund(t *testing.T) {
 	balances := input.BankKeeper.GetAllBalances(ctx, mySender)
 	require.Equal(t, sdk.NewInt(104), balances.AmountOf(myDenom))
 }
+
+func TestBatchTimeoutMeasuredBlockTimes(t *testing.T) {
+	input := CreateTestEnv(t)
+	k := input.GravityKeeper
+	params := k.GetParams(input.Context)
+	start := time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+	ctx := input.Context.WithBlockHeight(1).WithBlockTime(start)
+
+	// without measurements the params are used
+	assert.Equal(t, params.AverageBlockTime, k.GetCosmosBlockTime(ctx))
+	assert.Equal(t, params.AverageEthereumBlockTime, k.GetEthereumBlockTime(ctx))
+
+	// produce Cosmos blocks every 6 seconds and observe a new Ethereum height every 10 blocks
+	// of 12 seconds each
+	ethHeight := uint64(1000)
+	for i := 0; i <= 60; i++ {
+		ctx = ctx.WithBlockHeight(int64(i + 1)).WithBlockTime(start.Add(time.Duration(i) * 6 * time.Second))
+		if i%10 == 0 {
+			k.SetLastObservedEthereumBlockHeight(ctx, ethHeight)
+			ethHeight += 5
+		}
+		k.MeasureCosmosBlockTime(ctx)
+	}
+	lastEthHeight := ethHeight - 5
+	assert.Equal(t, uint64(6000), k.GetCosmosBlockTime(ctx))
+	assert.Equal(t, uint64(12000), k.GetEthereumBlockTime(ctx))
+
+	// ten Cosmos blocks after the last observation is a minute, or five Ethereum blocks, and the
+	// target timeout of a minute adds another five Ethereum blocks
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	assert.Equal(t, lastEthHeight+10, k.getBatchTimeoutHeight(ctx))
+
+	// a chain halt only moves the average by a bounded sample
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	k.MeasureCosmosBlockTime(ctx)
+	assert.Equal(t, uint64(6000+(2*params.AverageBlockTime-6000)/100), k.GetCosmosBlockTime(ctx))
+
+	// once no Ethereum height has been observed for a long time the param is used again
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(6 * time.Hour))
+	res, err := k.BlockTimes(sdk.WrapSDKContext(ctx), &types.QueryBlockTimesRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, params.AverageEthereumBlockTime, res.EthereumBlockTime)
+	assert.Equal(t, uint64(12000), res.EthereumMeasurement.AverageBlockTime)
+	assert.Equal(t, uint64(6), res.EthereumMeasurement.Samples)
+}
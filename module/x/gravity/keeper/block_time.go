@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+const (
+	// cosmosBlockTimeSmoothing is the number of samples the Cosmos block time is averaged over, a sample is
+	// taken every block
+	cosmosBlockTimeSmoothing = 100
+	// ethereumBlockTimeSmoothing is the number of samples the Ethereum block time is averaged over, a sample
+	// is taken every time a higher Ethereum block height is observed
+	ethereumBlockTimeSmoothing = 10
+	// minBlockTimeSamples is the number of samples a measurement needs before it is used over the params
+	minBlockTimeSamples = 5
+	// maxBlockTimeAge is how long in milliseconds a measurement is used for after its last sample, the
+	// Ethereum block time in particular is not sampled while no Ethereum heights are being observed
+	maxBlockTimeAge = 6 * 60 * 60 * 1000
+	// blockTimeBoundFactor bounds both samples and measured block times to within this factor of the
+	// AverageBlockTime and AverageEthereumBlockTime params, this keeps a chain halt or a burst of
+	// delayed observations from pushing timeouts far from their target
+	blockTimeBoundFactor = 2
+)
+
+// MeasureCosmosBlockTime samples the time since the last measured block and updates the Cosmos block time
+// moving average, this is called once per block in the EndBlocker
+func (k Keeper) MeasureCosmosBlockTime(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	m := k.GetCosmosBlockTimeMeasurement(ctx)
+	updateBlockTimeMeasurement(&m, uint64(ctx.BlockHeight()), blockTimeMillis(ctx), params.AverageBlockTime, cosmosBlockTimeSmoothing)
+	k.setBlockTimeMeasurement(ctx, types.CosmosBlockTimeKey, m)
+}
+
+// measureEthereumBlockTime samples the time since the last observed Ethereum block height and updates the
+// Ethereum block time moving average, this is called whenever a new Ethereum block height is observed
+func (k Keeper) measureEthereumBlockTime(ctx sdk.Context, ethereumHeight uint64) {
+	params := k.GetParams(ctx)
+	m := k.GetEthereumBlockTimeMeasurement(ctx)
+	updateBlockTimeMeasurement(&m, ethereumHeight, blockTimeMillis(ctx), params.AverageEthereumBlockTime, ethereumBlockTimeSmoothing)
+	k.setBlockTimeMeasurement(ctx, types.EthereumBlockTimeKey, m)
+}
+
+// GetCosmosBlockTime returns the Cosmos block time in milliseconds used to project heights, this is the
+// measured average if it is fresh and the AverageBlockTime param otherwise
+func (k Keeper) GetCosmosBlockTime(ctx sdk.Context) uint64 {
+	return effectiveBlockTime(ctx, k.GetCosmosBlockTimeMeasurement(ctx), k.GetParams(ctx).AverageBlockTime)
+}
+
+// GetEthereumBlockTime returns the Ethereum block time in milliseconds used to project heights, this is the
+// measured average if it is fresh and the AverageEthereumBlockTime param otherwise
+func (k Keeper) GetEthereumBlockTime(ctx sdk.Context) uint64 {
+	return effectiveBlockTime(ctx, k.GetEthereumBlockTimeMeasurement(ctx), k.GetParams(ctx).AverageEthereumBlockTime)
+}
+
+// GetCosmosBlockTimeMeasurement returns the measured Cosmos block time
+func (k Keeper) GetCosmosBlockTimeMeasurement(ctx sdk.Context) types.BlockTimeMeasurement {
+	return k.getBlockTimeMeasurement(ctx, types.CosmosBlockTimeKey)
+}
+
+// GetEthereumBlockTimeMeasurement returns the measured Ethereum block time
+func (k Keeper) GetEthereumBlockTimeMeasurement(ctx sdk.Context) types.BlockTimeMeasurement {
+	return k.getBlockTimeMeasurement(ctx, types.EthereumBlockTimeKey)
+}
+
+func (k Keeper) getBlockTimeMeasurement(ctx sdk.Context, key []byte) types.BlockTimeMeasurement {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(key)
+	m := types.BlockTimeMeasurement{
+		AverageBlockTime: 0,
+		Samples:          0,
+		LastBlockHeight:  0,
+		LastBlockTime:    0,
+	}
+	if len(bz) == 0 {
+		return m
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &m)
+	return m
+}
+
+func (k Keeper) setBlockTimeMeasurement(ctx sdk.Context, key []byte, m types.BlockTimeMeasurement) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(key, k.cdc.MustMarshalBinaryBare(&m))
+}
+
+// updateBlockTimeMeasurement adds a sample taken at the given height and time to the measurement. The first
+// call only records a starting point, a sample is the time elapsed per block since the previous call and is
+// bounded relative to param before being folded into an exponential moving average over smoothing samples.
+func updateBlockTimeMeasurement(m *types.BlockTimeMeasurement, height uint64, timeMillis uint64, param uint64, smoothing uint64) {
+	if m.LastBlockTime != 0 && height > m.LastBlockHeight && timeMillis > m.LastBlockTime {
+		sample := boundBlockTime((timeMillis-m.LastBlockTime)/(height-m.LastBlockHeight), param)
+		if m.Samples == 0 {
+			m.AverageBlockTime = sample
+		} else {
+			// computed in signed integers since the sample may be lower than the average
+			avg := int64(m.AverageBlockTime)
+			avg += (int64(sample) - avg) / int64(smoothing)
+			m.AverageBlockTime = uint64(avg)
+		}
+		m.Samples++
+	}
+	m.LastBlockHeight = height
+	m.LastBlockTime = timeMillis
+}
+
+// effectiveBlockTime returns the measured block time bounded relative to param, or param itself if the
+// measurement does not have enough samples or has not been sampled recently
+func effectiveBlockTime(ctx sdk.Context, m types.BlockTimeMeasurement, param uint64) uint64 {
+	if m.Samples < minBlockTimeSamples || blockTimeMillis(ctx) > m.LastBlockTime+maxBlockTimeAge {
+		return param
+	}
+	return boundBlockTime(m.AverageBlockTime, param)
+}
+
+// boundBlockTime limits a block time to within blockTimeBoundFactor of param
+func boundBlockTime(blockTime uint64, param uint64) uint64 {
+	if lower := param / blockTimeBoundFactor; blockTime < lower {
+		return lower
+	}
+	if upper := param * blockTimeBoundFactor; blockTime > upper {
+		return upper
+	}
+	return blockTime
+}
+
+// blockTimeMillis returns the current block time in milliseconds since the epoch, or zero if the block time
+// is unset
+func blockTimeMillis(ctx sdk.Context) uint64 {
+	if ctx.BlockTime().Unix() <= 0 {
+		return 0
+	}
+	return uint64(ctx.BlockTime().UnixNano() / 1e6)
+}
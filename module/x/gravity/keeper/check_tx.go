@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// CheckOrchestratorMsg performs the cheap stateful checks of an orchestrator message without executing it,
+// so that confirms and claims which are bound to fail can be kept out of the mempool. Accepted confirms and
+// claim nonces are recorded in the given context so that the following messages and transactions of the same
+// orchestrator are checked against them, this must therefore only be used on the CheckTx state. The Ethereum
+// signature of a confirm is verified before it is recorded, otherwise a confirm with a bad signature would
+// keep the valid confirm of the same orchestrator out of the mempool.
+func (k Keeper) CheckOrchestratorMsg(ctx sdk.Context, msg sdk.Msg) error {
+	switch msg := msg.(type) {
+	case *types.MsgValsetConfirm:
+		orchaddr, err := k.checkOrchestratorRegistered(ctx, msg.Orchestrator)
+		if err != nil {
+			return err
+		}
+		valset := k.GetValset(ctx, msg.Nonce)
+		if valset == nil {
+			return sdkerrors.Wrap(types.ErrInvalid, "couldn't find valset")
+		}
+		if k.GetValsetConfirm(ctx, msg.Nonce, orchaddr) != nil {
+			return sdkerrors.Wrap(types.ErrDuplicate, "signature duplicate")
+		}
+		if err := k.confirmHandlerCommon(ctx, msg.Orchestrator, msg.Signature, valset.GetCheckpoint(k.GetGravityID(ctx))); err != nil {
+			return err
+		}
+		k.SetValsetConfirm(ctx, *msg)
+
+	case *types.MsgConfirmBatch:
+		orchaddr, err := k.checkOrchestratorRegistered(ctx, msg.Orchestrator)
+		if err != nil {
+			return err
+		}
+		batch := k.GetOutgoingTXBatch(ctx, msg.TokenContract, msg.Nonce)
+		if batch == nil {
+			return sdkerrors.Wrap(types.ErrInvalid, "couldn't find batch")
+		}
+		if k.GetBatchConfirm(ctx, msg.Nonce, msg.TokenContract, orchaddr) != nil {
+			return sdkerrors.Wrap(types.ErrDuplicate, "duplicate signature")
+		}
+		if err := k.confirmHandlerCommon(ctx, msg.Orchestrator, msg.Signature, batch.GetCheckpoint(k.GetGravityID(ctx))); err != nil {
+			return err
+		}
+		k.SetBatchConfirm(ctx, msg)
+
+	case *types.MsgConfirmLogicCall:
+		orchaddr, err := k.checkOrchestratorRegistered(ctx, msg.Orchestrator)
+		if err != nil {
+			return err
+		}
+		invalidationIdBytes, err := hex.DecodeString(msg.InvalidationId)
+		if err != nil {
+			return sdkerrors.Wrap(types.ErrInvalid, "invalidation id encoding")
+		}
+		logic := k.GetOutgoingLogicCall(ctx, invalidationIdBytes, msg.InvalidationNonce)
+		if logic == nil {
+			return sdkerrors.Wrap(types.ErrInvalid, "couldn't find logic")
+		}
+		if k.GetLogicCallConfirm(ctx, invalidationIdBytes, msg.InvalidationNonce, orchaddr) != nil {
+			return sdkerrors.Wrap(types.ErrDuplicate, "duplicate signature")
+		}
+		if err := k.confirmHandlerCommon(ctx, msg.Orchestrator, msg.Signature, logic.GetCheckpoint(k.GetGravityID(ctx))); err != nil {
+			return err
+		}
+		k.SetLogicCallConfirm(ctx, msg)
+
+	case *types.MsgSubmitClaims:
+		if err := k.checkOrchestratorValidatorInSet(ctx, msg.Orchestrator); err != nil {
+			return err
+		}
+		claims, err := msg.GetEthereumClaims()
+		if err != nil {
+			return err
+		}
+		for i, claim := range claims {
+			if err := k.checkClaimEventNonce(ctx, claim); err != nil {
+				return sdkerrors.Wrapf(err, "claim %d", i)
+			}
+		}
+
+	case *types.MsgEthereumHeightClaim:
+		// height claims have their own nonce space which is checked when they are attested
+		return k.checkOrchestratorValidatorInSet(ctx, msg.Orchestrator)
+
+	case types.EthereumClaim:
+		if err := k.checkOrchestratorValidatorInSet(ctx, msg.GetClaimer().String()); err != nil {
+			return err
+		}
+		return k.checkClaimEventNonce(ctx, msg)
+	}
+	return nil
+}
+
+// checkOrchestratorValidatorInSet checks that the orchestrator refers to a validator that is
+// currently in the set
+func (k Keeper) checkOrchestratorValidatorInSet(ctx sdk.Context, orchestrator string) error {
+	orchaddr, _ := sdk.AccAddressFromBech32(orchestrator)
+	validator, found := k.GetOrchestratorValidator(ctx, orchaddr)
+	if !found {
+		return sdkerrors.Wrap(types.ErrUnknown, "validator")
+	}
+
+	// return an error if the validator isn't in the active set
+	val := k.StakingKeeper.Validator(ctx, validator.GetOperator())
+	if val == nil || !val.IsBonded() {
+		return sdkerrors.Wrap(sdkerrors.ErrorInvalidSigner, "validator not in active set")
+	}
+
+	return nil
+}
+
+// checkOrchestratorRegistered checks that the orchestrator refers to an existing validator. Unlike claims,
+// confirms are not restricted to the active set since unbonding validators are still slashed for missing them
+func (k Keeper) checkOrchestratorRegistered(ctx sdk.Context, orchestrator string) (sdk.AccAddress, error) {
+	orchaddr, err := sdk.AccAddressFromBech32(orchestrator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, orchestrator)
+	}
+	validator, found := k.GetOrchestratorValidator(ctx, orchaddr)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknown, "validator")
+	}
+	if k.StakingKeeper.Validator(ctx, validator.GetOperator()) == nil {
+		return nil, sdkerrors.Wrap(types.ErrUnknown, "validator")
+	}
+	return orchaddr, nil
+}
+
+// checkClaimEventNonce checks that the claim is the next one expected from its validator and records it
+func (k Keeper) checkClaimEventNonce(ctx sdk.Context, claim types.EthereumClaim) error {
+	val, found := k.GetOrchestratorValidator(ctx, claim.GetClaimer())
+	if !found {
+		return sdkerrors.Wrap(types.ErrUnknown, "validator")
+	}
+	if claim.GetEventNonce() != k.GetLastEventNonceByValidator(ctx, val.GetOperator())+1 {
+		return types.ErrNonContiguousEventNonce
+	}
+	k.setLastEventNonceByValidator(ctx, val.GetOperator(), claim.GetEventNonce())
+	return nil
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+//nolint: exhaustivestruct
+func TestCheckOrchestratorMsg(t *testing.T) {
+	input, ctx := SetupTestChain(t, 2)
+	k := input.GravityKeeper
+	validator := input.StakingKeeper.GetBondedValidatorsByPower(ctx)[0]
+	orch := sdk.AccAddress(validator.GetOperator())
+	ethKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	ethAddr := crypto.PubkeyToAddress(ethKey.PublicKey).String()
+	k.SetEthAddressForValidator(ctx, validator.GetOperator(), ethAddr)
+
+	// confirms with a bad signature are rejected without being recorded
+	valset := k.SetValsetRequest(ctx)
+	confirm := &types.MsgValsetConfirm{Nonce: valset.Nonce, Orchestrator: orch.String(), EthAddress: ethAddr, Signature: "signature"}
+	assert.True(t, types.ErrInvalid.Is(k.CheckOrchestratorMsg(ctx, confirm)))
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sig, err := types.NewEthereumSignature(valset.GetCheckpoint(k.GetGravityID(ctx)), otherKey)
+	require.NoError(t, err)
+	confirm.Signature = hex.EncodeToString(sig)
+	assert.True(t, types.ErrInvalid.Is(k.CheckOrchestratorMsg(ctx, confirm)))
+	assert.Nil(t, k.GetValsetConfirm(ctx, valset.Nonce, orch))
+
+	// confirms need an existing target and are accepted once per orchestrator
+	sig, err = types.NewEthereumSignature(valset.GetCheckpoint(k.GetGravityID(ctx)), ethKey)
+	require.NoError(t, err)
+	confirm.Signature = hex.EncodeToString(sig)
+	require.NoError(t, k.CheckOrchestratorMsg(ctx, confirm))
+	assert.True(t, types.ErrDuplicate.Is(k.CheckOrchestratorMsg(ctx, confirm)))
+	confirm.Nonce++
+	assert.Error(t, k.CheckOrchestratorMsg(ctx, confirm))
+	assert.Error(t, k.CheckOrchestratorMsg(ctx, &types.MsgConfirmBatch{
+		Nonce:         1,
+		TokenContract: "0x0bc529c00C6401aEF6D220BE8C6Ea1667F6Ad93e",
+		Orchestrator:  orch.String(),
+		EthSigner:     ethAddr,
+		Signature:     "signature",
+	}))
+
+	// unknown orchestrators are rejected
+	unknown := &types.MsgValsetConfirm{Nonce: valset.Nonce, Orchestrator: AccAddrs[0].String(), EthAddress: ethAddr}
+	assert.Error(t, k.CheckOrchestratorMsg(ctx, unknown))
+
+	// claims must carry the next event nonce of the validator
+	deposit := func(nonce uint64) *types.MsgSendToCosmosClaim {
+		return &types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			TokenContract:  "0x0bc529c00C6401aEF6D220BE8C6Ea1667F6Ad93e",
+			Amount:         sdk.NewInt(100),
+			EthereumSender: EthAddrs[0].String(),
+			CosmosReceiver: AccAddrs[0].String(),
+			Orchestrator:   orch.String(),
+		}
+	}
+	assert.True(t, types.ErrNonContiguousEventNonce.Is(k.CheckOrchestratorMsg(ctx, deposit(2))))
+	require.NoError(t, k.CheckOrchestratorMsg(ctx, deposit(1)))
+	assert.Error(t, k.CheckOrchestratorMsg(ctx, deposit(1)))
+
+	batched, err := types.NewMsgSubmitClaims(orch, deposit(2), deposit(3))
+	require.NoError(t, err)
+	require.NoError(t, k.CheckOrchestratorMsg(ctx, batched))
+	assert.Equal(t, uint64(3), k.GetLastEventNonceByValidator(ctx, validator.GetOperator()))
+	assert.Error(t, k.CheckOrchestratorMsg(ctx, batched))
+}
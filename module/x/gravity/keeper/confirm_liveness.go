@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// HandleConfirmLiveness records whether a validator confirmed a single valset, batch or logic call that it was
+// expected to confirm, in the style of the x/slashing signed blocks window. It returns true if the validator
+// has now confirmed fewer than MinConfirmsPerWindow of the last ConfirmsWindow items of this type, in which case
+// the caller should slash the validator. The missed confirms are reset at the same time so that the validator
+// is not slashed again for the same misses.
+func (k Keeper) HandleConfirmLiveness(
+	ctx sdk.Context,
+	params types.Params,
+	confirmType types.ConfirmType,
+	validator sdk.ValAddress,
+	confirmed bool,
+) bool {
+	info := k.GetConfirmLivenessInfo(ctx, confirmType, validator)
+
+	// this is a relative index, so it counts confirms the validator *should* have made within the window
+	index := info.IndexOffset % params.ConfirmsWindow
+	info.IndexOffset++
+
+	// update the bit array and counter, the counter tracks the sum of the bit array
+	previous := k.getMissedConfirmBit(ctx, confirmType, validator, index)
+	switch {
+	case !previous && !confirmed:
+		k.setMissedConfirmBit(ctx, confirmType, validator, index, true)
+		info.MissedConfirmsCounter++
+	case previous && confirmed:
+		k.setMissedConfirmBit(ctx, confirmType, validator, index, false)
+		info.MissedConfirmsCounter--
+	}
+
+	minConfirms := params.MinConfirmsPerWindow.MulInt64(int64(params.ConfirmsWindow)).RoundInt64()
+	maxMissed := int64(params.ConfirmsWindow) - minConfirms
+	if int64(info.MissedConfirmsCounter) <= maxMissed {
+		k.setConfirmLivenessInfo(ctx, info)
+		return false
+	}
+
+	// the validator starts over with a clean window once it is punished
+	k.clearMissedConfirmBitArray(ctx, confirmType, validator)
+	info.IndexOffset = 0
+	info.MissedConfirmsCounter = 0
+	k.setConfirmLivenessInfo(ctx, info)
+	return true
+}
+
+// GetConfirmLivenessInfo returns the confirm liveness info of a validator for the given confirm type
+func (k Keeper) GetConfirmLivenessInfo(ctx sdk.Context, confirmType types.ConfirmType, validator sdk.ValAddress) types.ConfirmLivenessInfo {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetConfirmLivenessInfoKey(confirmType, validator))
+	info := types.ConfirmLivenessInfo{
+		Validator:             validator.String(),
+		ConfirmType:           confirmType,
+		IndexOffset:           0,
+		MissedConfirmsCounter: 0,
+	}
+	if len(bz) == 0 {
+		return info
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &info)
+	return info
+}
+
+// setConfirmLivenessInfo sets the confirm liveness info of a validator
+func (k Keeper) setConfirmLivenessInfo(ctx sdk.Context, info types.ConfirmLivenessInfo) {
+	validator, err := sdk.ValAddressFromBech32(info.Validator)
+	if err != nil {
+		panic(err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetConfirmLivenessInfoKey(info.ConfirmType, validator), k.cdc.MustMarshalBinaryBare(&info))
+}
+
+// getMissedConfirmBit returns true if the validator missed the confirm at the given index of the window
+func (k Keeper) getMissedConfirmBit(ctx sdk.Context, confirmType types.ConfirmType, validator sdk.ValAddress, index uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetMissedConfirmBitArrayKey(confirmType, validator, index))
+}
+
+// setMissedConfirmBit records whether the validator missed the confirm at the given index of the window, only
+// misses are stored
+func (k Keeper) setMissedConfirmBit(ctx sdk.Context, confirmType types.ConfirmType, validator sdk.ValAddress, index uint64, missed bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetMissedConfirmBitArrayKey(confirmType, validator, index)
+	if missed {
+		store.Set(key, []byte{1})
+	} else {
+		store.Delete(key)
+	}
+}
+
+// clearMissedConfirmBitArray deletes every recorded miss of the validator for the given confirm type
+func (k Keeper) clearMissedConfirmBitArray(ctx sdk.Context, confirmType types.ConfirmType, validator sdk.ValAddress) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetMissedConfirmBitArrayPrefix(confirmType, validator))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+func TestHandleConfirmLiveness(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	params := k.GetParams(ctx)
+	params.ConfirmsWindow = 10
+	params.MinConfirmsPerWindow = sdk.NewDecWithPrec(5, 1)
+	val := ValAddrs[0]
+
+	// up to half of the window may be missed
+	for i := 0; i < 5; i++ {
+		assert.False(t, k.HandleConfirmLiveness(ctx, params, types.CONFIRM_TYPE_BATCH, val, false))
+	}
+	assert.Equal(t, uint64(5), k.GetConfirmLivenessInfo(ctx, types.CONFIRM_TYPE_BATCH, val).MissedConfirmsCounter)
+
+	// confirm types are tracked separately
+	assert.False(t, k.HandleConfirmLiveness(ctx, params, types.CONFIRM_TYPE_VALSET, val, false))
+	assert.Equal(t, uint64(1), k.GetConfirmLivenessInfo(ctx, types.CONFIRM_TYPE_VALSET, val).MissedConfirmsCounter)
+
+	// confirming the rest of the window keeps the validator live, then as the window wraps
+	// around confirms replace the oldest misses
+	for i := 0; i < 7; i++ {
+		assert.False(t, k.HandleConfirmLiveness(ctx, params, types.CONFIRM_TYPE_BATCH, val, true))
+	}
+	info := k.GetConfirmLivenessInfo(ctx, types.CONFIRM_TYPE_BATCH, val)
+	assert.Equal(t, uint64(12), info.IndexOffset)
+	assert.Equal(t, uint64(3), info.MissedConfirmsCounter)
+
+	// missing over the three remaining misses of the window only counts the new ones, so
+	// five misses bring the validator to the limit and the sixth is one too many
+	for i := 0; i < 5; i++ {
+		assert.False(t, k.HandleConfirmLiveness(ctx, params, types.CONFIRM_TYPE_BATCH, val, false))
+	}
+	assert.Equal(t, uint64(5), k.GetConfirmLivenessInfo(ctx, types.CONFIRM_TYPE_BATCH, val).MissedConfirmsCounter)
+	assert.True(t, k.HandleConfirmLiveness(ctx, params, types.CONFIRM_TYPE_BATCH, val, false))
+
+	// after which the validator starts over with a clean window
+	info = k.GetConfirmLivenessInfo(ctx, types.CONFIRM_TYPE_BATCH, val)
+	assert.Equal(t, uint64(0), info.IndexOffset)
+	assert.Equal(t, uint64(0), info.MissedConfirmsCounter)
+	for i := uint64(0); i < params.ConfirmsWindow; i++ {
+		assert.False(t, k.getMissedConfirmBit(ctx, types.CONFIRM_TYPE_BATCH, val, i))
+	}
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"encoding/hex"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//     CONFIRM PRUNING     //
+/////////////////////////////
+
+// scheduleConfirmPruning deletes all confirms stored under confirmPrefix once the chain reaches the given height,
+// at which point the slashing window of the valset, batch or logic call they confirm has passed. Confirms that
+// are already past their window are deleted right away.
+func (k Keeper) scheduleConfirmPruning(ctx sdk.Context, height uint64, confirmPrefix []byte) {
+	if height <= uint64(ctx.BlockHeight()) {
+		k.deleteConfirms(ctx, confirmPrefix)
+		return
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetPendingConfirmPruneKey(height, confirmPrefix), confirmPrefix)
+}
+
+// PruneConfirms deletes the confirms of deleted valsets, batches and logic calls whose slashing window has passed
+func (k Keeper) PruneConfirms(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.PendingConfirmPruneKey)
+	iter := prefixStore.Iterator(nil, types.UInt64Bytes(uint64(ctx.BlockHeight())+1))
+	defer iter.Close()
+
+	var pending [][]byte
+	for ; iter.Valid(); iter.Next() {
+		pending = append(pending, iter.Key())
+		k.deleteConfirms(ctx, iter.Value())
+	}
+	for _, key := range pending {
+		prefixStore.Delete(key)
+	}
+}
+
+// deleteConfirms deletes every confirm stored under the given prefix
+func (k Keeper) deleteConfirms(ctx sdk.Context, confirmPrefix []byte) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), confirmPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+}
+
+// PruneOrphanedConfirms deletes all confirms whose valset, batch or logic call no longer exists. Before confirms
+// were pruned together with their parent they were left behind, this is run once as a store migration to clean
+// them up.
+func (k Keeper) PruneOrphanedConfirms(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	var orphans [][]byte
+
+	valsetConfirms := prefix.NewStore(store, types.ValsetConfirmKey)
+	iter := valsetConfirms.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		var confirm types.MsgValsetConfirm
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &confirm)
+		if !k.HasValsetRequest(ctx, confirm.Nonce) {
+			orphans = append(orphans, append(types.ValsetConfirmKey, iter.Key()...))
+		}
+	}
+	iter.Close()
+
+	batchConfirms := prefix.NewStore(store, types.BatchConfirmKey)
+	iter = batchConfirms.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		var confirm types.MsgConfirmBatch
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &confirm)
+		if !store.Has(types.GetOutgoingTxBatchKey(confirm.TokenContract, confirm.Nonce)) {
+			orphans = append(orphans, append(types.BatchConfirmKey, iter.Key()...))
+		}
+	}
+	iter.Close()
+
+	logicConfirms := prefix.NewStore(store, types.KeyOutgoingLogicConfirm)
+	iter = logicConfirms.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		var confirm types.MsgConfirmLogicCall
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &confirm)
+		invalidationID, err := hex.DecodeString(confirm.InvalidationId)
+		if err != nil || !store.Has(types.GetOutgoingLogicCallKey(invalidationID, confirm.InvalidationNonce)) {
+			orphans = append(orphans, append(types.KeyOutgoingLogicConfirm, iter.Key()...))
+		}
+	}
+	iter.Close()
+
+	for _, key := range orphans {
+		store.Delete(key)
+	}
+}
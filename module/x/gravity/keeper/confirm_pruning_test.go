@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+//nolint: exhaustivestruct
+func TestBatchConfirmsPrunedAfterWindow(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	params := k.GetParams(ctx)
+	tokenContract := "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B"
+
+	batch := &types.OutgoingTxBatch{BatchNonce: 1, TokenContract: tokenContract}
+	k.StoreBatch(ctx, batch)
+	k.SetBatchConfirm(ctx, &types.MsgConfirmBatch{
+		Nonce:         batch.BatchNonce,
+		TokenContract: tokenContract,
+		EthSigner:     EthAddrs[0].String(),
+		Orchestrator:  AccAddrs[0].String(),
+		Signature:     "signature",
+	})
+
+	// the confirms outlive the batch until the slashing window has passed
+	k.DeleteBatch(ctx, *batch)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedBatchesWindow) - 1)
+	k.PruneConfirms(ctx)
+	assert.Len(t, k.GetBatchConfirmByNonceAndTokenContract(ctx, batch.BatchNonce, tokenContract), 1)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	k.PruneConfirms(ctx)
+	assert.Empty(t, k.GetBatchConfirmByNonceAndTokenContract(ctx, batch.BatchNonce, tokenContract))
+
+	// the pending record is gone as well
+	assert.Nil(t, ctx.KVStore(k.storeKey).Get(types.GetPendingConfirmPruneKey(
+		batch.Block+params.SignedBatchesWindow, types.GetBatchConfirmKey(tokenContract, batch.BatchNonce, nil))))
+}
+
+//nolint: exhaustivestruct
+func TestDeleteMissingLogicCall(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	// deleting a logic call that was never stored schedules nothing and does not panic
+	k.DeleteOutgoingLogicCall(ctx, []byte("invalidation id"), 1)
+	iter := ctx.KVStore(k.storeKey).Iterator(prefixRange(types.PendingConfirmPruneKey))
+	defer iter.Close()
+	assert.False(t, iter.Valid())
+}
+
+//nolint: exhaustivestruct
+func TestPruneOrphanedConfirms(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	tokenContract := "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B"
+	invalidationID := []byte("invalidation id")
+
+	// valset 1, batch 1 and logic call 1 exist, everything confirmed at nonce 2 is orphaned
+	valset := k.SetValsetRequest(ctx)
+	k.StoreBatch(ctx, &types.OutgoingTxBatch{BatchNonce: 1, TokenContract: tokenContract})
+	k.SetOutgoingLogicCall(ctx, &types.OutgoingLogicCall{InvalidationId: invalidationID, InvalidationNonce: 1})
+	for _, nonce := range []uint64{valset.Nonce, valset.Nonce + 1} {
+		k.SetValsetConfirm(ctx, types.MsgValsetConfirm{
+			Nonce:        nonce,
+			Orchestrator: AccAddrs[0].String(),
+			EthAddress:   EthAddrs[0].String(),
+			Signature:    "signature",
+		})
+	}
+	for _, nonce := range []uint64{1, 2} {
+		k.SetBatchConfirm(ctx, &types.MsgConfirmBatch{
+			Nonce:         nonce,
+			TokenContract: tokenContract,
+			EthSigner:     EthAddrs[0].String(),
+			Orchestrator:  AccAddrs[0].String(),
+			Signature:     "signature",
+		})
+		k.SetLogicCallConfirm(ctx, &types.MsgConfirmLogicCall{
+			InvalidationId:    hex.EncodeToString(invalidationID),
+			InvalidationNonce: nonce,
+			EthSigner:         EthAddrs[0].String(),
+			Orchestrator:      AccAddrs[0].String(),
+			Signature:         "signature",
+		})
+	}
+
+	k.PruneOrphanedConfirms(ctx)
+
+	require.Len(t, k.GetValsetConfirms(ctx, valset.Nonce), 1)
+	assert.Empty(t, k.GetValsetConfirms(ctx, valset.Nonce+1))
+	require.Len(t, k.GetBatchConfirmByNonceAndTokenContract(ctx, 1, tokenContract), 1)
+	assert.Empty(t, k.GetBatchConfirmByNonceAndTokenContract(ctx, 2, tokenContract))
+	require.Len(t, k.GetLogicConfirmByInvalidationIDAndNonce(ctx, invalidationID, 1), 1)
+	assert.Empty(t, k.GetLogicConfirmByInvalidationIDAndNonce(ctx, invalidationID, 2))
+}
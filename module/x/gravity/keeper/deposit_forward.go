@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//    DEPOSIT FORWARDING   //
+/////////////////////////////
+
+// SetPendingDepositForward stores a deposit forwarded over IBC until its packet is acknowledged or times out
+func (k Keeper) SetPendingDepositForward(ctx sdk.Context, forward types.PendingDepositForward) {
+	key := types.GetPendingDepositForwardKey(forward.Channel, forward.Sequence)
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshalBinaryBare(&forward))
+}
+
+// GetPendingDepositForward returns the deposit forwarded in the packet with the given channel and sequence
+func (k Keeper) GetPendingDepositForward(ctx sdk.Context, channel string, sequence uint64) (types.PendingDepositForward, bool) {
+	var forward types.PendingDepositForward
+	bz := ctx.KVStore(k.storeKey).Get(types.GetPendingDepositForwardKey(channel, sequence))
+	if bz == nil {
+		return forward, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &forward)
+	return forward, true
+}
+
+// GetPendingDepositForwards returns all deposits forwarded over IBC whose packets are not acknowledged yet
+func (k Keeper) GetPendingDepositForwards(ctx sdk.Context) (out []types.PendingDepositForward) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingDepositForwardKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var forward types.PendingDepositForward
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &forward)
+		out = append(out, forward)
+	}
+	return
+}
+
+// CompleteDepositForward is called once the transfer packet with the given channel and sequence is acknowledged
+// or times out. If the packet forwarded a deposit and was refunded, which the transfer module does to the
+// gravity module account the deposit was sent from, the deposit is paid to its fallback account.
+func (k Keeper) CompleteDepositForward(ctx sdk.Context, channel string, sequence uint64, refunded bool) error {
+	forward, found := k.GetPendingDepositForward(ctx, channel, sequence)
+	if !found {
+		return nil
+	}
+	ctx.KVStore(k.storeKey).Delete(types.GetPendingDepositForwardKey(channel, sequence))
+	if !refunded {
+		return nil
+	}
+
+	fallback, err := sdk.AccAddressFromBech32(forward.Fallback)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "deposit forward fallback %s", forward.Fallback)
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, fallback, sdk.Coins{forward.Amount}); err != nil {
+		return sdkerrors.Wrap(err, "refund deposit forward")
+	}
+	k.logger(ctx).Info("deposit forward refunded, paid to fallback account",
+		"nonce", fmt.Sprint(forward.EventNonce),
+		"channel", channel,
+		"sequence", fmt.Sprint(sequence),
+		"fallback", forward.Fallback,
+	)
+	k.EmitTypedEvent(ctx, &types.EventDepositForwardRefunded{
+		EventNonce: forward.EventNonce,
+		Channel:    channel,
+		Sequence:   sequence,
+		Fallback:   forward.Fallback,
+		Amount:     forward.Amount,
+	})
+	return nil
+}
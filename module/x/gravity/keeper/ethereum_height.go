@@ -0,0 +1,225 @@
+package keeper
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// attestEthereumHeight records a validators vote on an Ethereum height claim. Height claims are heartbeats
+// rather than Ethereum events, they are counted in their own nonce space and only need to be newer than both
+// the validators last height claim and the last observed height claim. This way a validator that has been
+// offline does not need to replay every heartbeat it missed before it can vote again.
+func (k Keeper) attestEthereumHeight(
+	ctx sdk.Context,
+	valAddr sdk.ValAddress,
+	claim types.EthereumClaim,
+	anyClaim *codectypes.Any,
+) (*types.Attestation, error) {
+	heightClaim, ok := claim.(*types.MsgEthereumHeightClaim)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "unexpected claim %T for claim type %s", claim, claim.GetType())
+	}
+
+	lastNonce := k.GetOrchestratorVersion(ctx, valAddr).HeightNonce
+	if lastObservedNonce := k.GetLastObservedEthereumHeightNonce(ctx); lastObservedNonce > lastNonce {
+		lastNonce = lastObservedNonce
+	}
+	if claim.GetEventNonce() <= lastNonce {
+		return nil, sdkerrors.Wrapf(types.ErrNonContiguousEventNonce, "height nonce %d is not newer than %d", claim.GetEventNonce(), lastNonce)
+	}
+
+	att := k.GetEthereumHeightAttestation(ctx, claim.GetEventNonce(), claim.ClaimHash())
+	if att == nil {
+		att = &types.Attestation{
+			Observed:    false,
+			Votes:       []string{},
+			Height:      uint64(ctx.BlockHeight()),
+			Claim:       anyClaim,
+			VoteDetails: []types.AttestationVote{},
+		}
+	}
+	att.Votes = append(att.Votes, valAddr.String())
+	att.VoteDetails = append(att.VoteDetails, k.newAttestationVote(ctx, valAddr))
+
+	k.SetEthereumHeightAttestation(ctx, claim.GetEventNonce(), claim.ClaimHash(), att)
+	k.setOrchestratorVersion(ctx, valAddr, types.OrchestratorVersion{
+		Validator:   valAddr.String(),
+		Version:     heightClaim.OrchestratorVersion,
+		HeightNonce: claim.GetEventNonce(),
+	})
+
+	return att, nil
+}
+
+// observeEthereumHeight applies an Ethereum height claim that has passed the vote threshold. Heartbeats only
+// ever move the Ethereum height forward, a claim for a height that an observed event has already passed is
+// marked observed without touching the height
+func (k Keeper) observeEthereumHeight(ctx sdk.Context, att *types.Attestation, claim types.EthereumClaim) {
+	// this check is performed when the claim is attested and again in the EndBlocker so this should never
+	// panic outside of programmer error.
+	if claim.GetEventNonce() <= k.GetLastObservedEthereumHeightNonce(ctx) {
+		panic("attempting to apply an outdated Ethereum height claim")
+	}
+	k.setLastObservedEthereumHeightNonce(ctx, claim.GetEventNonce())
+	if claim.GetBlockHeight() > k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight {
+		k.SetLastObservedEthereumBlockHeight(ctx, claim.GetBlockHeight())
+	}
+
+	att.Observed = true
+	k.SetEthereumHeightAttestation(ctx, claim.GetEventNonce(), claim.ClaimHash(), att)
+
+	k.emitObservedEvent(ctx, att, claim)
+}
+
+// SetEthereumHeightAttestation sets the Ethereum height claim attestation in the store
+func (k Keeper) SetEthereumHeightAttestation(ctx sdk.Context, heightNonce uint64, claimHash []byte, att *types.Attestation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetEthereumHeightAttestationKey(heightNonce, claimHash), k.cdc.MustMarshalBinaryBare(att))
+}
+
+// GetEthereumHeightAttestation returns an Ethereum height claim attestation given a height nonce and claim hash
+func (k Keeper) GetEthereumHeightAttestation(ctx sdk.Context, heightNonce uint64, claimHash []byte) *types.Attestation {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetEthereumHeightAttestationKey(heightNonce, claimHash))
+	if len(bz) == 0 {
+		return nil
+	}
+	var att types.Attestation
+	k.cdc.MustUnmarshalBinaryBare(bz, &att)
+	return &att
+}
+
+// IterateEthereumHeightAttestations iterates through all Ethereum height claim attestations with a height nonce
+// of at least startNonce in ASC order of height nonce
+func (k Keeper) IterateEthereumHeightAttestations(ctx sdk.Context, startNonce uint64, cb func([]byte, types.Attestation) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.EthereumHeightAttestationKey)
+	iter := prefixStore.Iterator(types.UInt64Bytes(startNonce), nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var att types.Attestation
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &att)
+		// cb returns true to stop early
+		if cb(iter.Key(), att) {
+			return
+		}
+	}
+}
+
+// GetPendingEthereumHeightAttestations returns the Ethereum height claim attestations that are newer than
+// the last observed height claim, sorted by height nonce
+func (k Keeper) GetPendingEthereumHeightAttestations(ctx sdk.Context) (out []types.Attestation) {
+	lastObservedNonce := k.GetLastObservedEthereumHeightNonce(ctx)
+	k.IterateEthereumHeightAttestations(ctx, lastObservedNonce+1, func(_ []byte, att types.Attestation) bool {
+		out = append(out, att)
+		return false
+	})
+	return
+}
+
+// PruneEthereumHeightAttestations deletes every Ethereum height claim attestation older than the last observed
+// one, these can never be observed and only the most recent observed height is of any interest. Pending
+// attestations that have not been observed within EthereumHeightClaimTimeout blocks are deleted as well, so
+// a claim for a height no other orchestrator agrees with does not stay in the store forever. Validators that
+// voted for a deleted claim may report heights below it again.
+func (k Keeper) PruneEthereumHeightAttestations(ctx sdk.Context) {
+	lastObservedNonce := k.GetLastObservedEthereumHeightNonce(ctx)
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.EthereumHeightAttestationKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	var keys [][]byte
+	var timedOut []types.Attestation
+	for ; iter.Valid(); iter.Next() {
+		if types.UInt64FromBytes(iter.Key()[:8]) < lastObservedNonce {
+			keys = append(keys, iter.Key())
+			continue
+		}
+		var att types.Attestation
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &att)
+		if !att.Observed && att.Height+types.EthereumHeightClaimTimeout < uint64(ctx.BlockHeight()) {
+			keys = append(keys, iter.Key())
+			timedOut = append(timedOut, att)
+		}
+	}
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+
+	for _, att := range timedOut {
+		claim, err := k.UnpackAttestationClaim(&att)
+		if err != nil {
+			panic("couldn't cast to claim")
+		}
+		for _, vote := range att.Votes {
+			valAddr, err := sdk.ValAddressFromBech32(vote)
+			if err != nil {
+				panic(err)
+			}
+			version := k.GetOrchestratorVersion(ctx, valAddr)
+			if version.HeightNonce == claim.GetEventNonce() {
+				version.HeightNonce = lastObservedNonce
+				k.setOrchestratorVersion(ctx, valAddr, version)
+			}
+		}
+	}
+}
+
+// GetLastObservedEthereumHeightNonce returns the latest observed Ethereum height claim nonce
+func (k Keeper) GetLastObservedEthereumHeightNonce(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bytes := store.Get(types.LastObservedEthereumHeightNonceKey)
+
+	if len(bytes) == 0 {
+		return 0
+	}
+	return types.UInt64FromBytes(bytes)
+}
+
+// setLastObservedEthereumHeightNonce sets the latest observed Ethereum height claim nonce
+func (k Keeper) setLastObservedEthereumHeightNonce(ctx sdk.Context, nonce uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastObservedEthereumHeightNonceKey, types.UInt64Bytes(nonce))
+}
+
+// GetOrchestratorVersion returns the orchestrator version and the last Ethereum height claim nonce
+// submitted by the given validator
+func (k Keeper) GetOrchestratorVersion(ctx sdk.Context, validator sdk.ValAddress) types.OrchestratorVersion {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetOrchestratorVersionKey(validator))
+	version := types.OrchestratorVersion{
+		Validator:   validator.String(),
+		Version:     "",
+		HeightNonce: 0,
+	}
+	if len(bz) == 0 {
+		return version
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &version)
+	return version
+}
+
+// setOrchestratorVersion sets the orchestrator version record for the given validator
+func (k Keeper) setOrchestratorVersion(ctx sdk.Context, validator sdk.ValAddress, version types.OrchestratorVersion) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetOrchestratorVersionKey(validator), k.cdc.MustMarshalBinaryBare(&version))
+}
+
+// GetOrchestratorVersions returns the orchestrator version reported by every validator that has submitted
+// an Ethereum height claim
+func (k Keeper) GetOrchestratorVersions(ctx sdk.Context) (out []types.OrchestratorVersion) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.OrchestratorVersionKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var version types.OrchestratorVersion
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &version)
+		out = append(out, version)
+	}
+	return
+}
@@ -1,22 +1,38 @@
 package keeper
 
 import (
-	"bytes"
 	"encoding/hex"
 	"fmt"
+	"time"
 
+	metrics "github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 )
 
+// CheckBadSignatureEvidence handles a MsgSubmitBadSignatureEvidence as BadEthSignatureEvidence submitted at the
+// current height
 func (k Keeper) CheckBadSignatureEvidence(
 	ctx sdk.Context,
 	msg *types.MsgSubmitBadSignatureEvidence) error {
+	return k.HandleBadEthSignatureEvidence(ctx, types.NewBadEthSignatureEvidence(msg, ctx.BlockHeight()))
+}
+
+// HandleBadEthSignatureEvidence slashes, jails and tombstones the validator that made a bad Ethereum signature,
+// this is called by the x/evidence router
+func (k Keeper) HandleBadEthSignatureEvidence(
+	ctx sdk.Context,
+	evidence *types.BadEthSignatureEvidence) error {
 	var subject types.EthereumSigned
 
-	err := k.cdc.UnpackAny(msg.Subject, &subject)
+	err := k.cdc.UnpackAny(evidence.Subject, &subject)
 
 	if err != nil {
 		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("Invalid Any encoded evidence %s", err))
@@ -24,18 +40,39 @@ func (k Keeper) CheckBadSignatureEvidence(
 
 	switch subject := subject.(type) {
 	case *types.OutgoingTxBatch:
-		return k.checkBadSignatureEvidenceInternal(ctx, subject, msg.Signature)
+		return k.checkBadSignatureEvidenceInternal(ctx, subject, evidence)
 	case *types.Valset:
-		return k.checkBadSignatureEvidenceInternal(ctx, subject, msg.Signature)
+		return k.checkBadSignatureEvidenceInternal(ctx, subject, evidence)
 	case *types.OutgoingLogicCall:
-		return k.checkBadSignatureEvidenceInternal(ctx, subject, msg.Signature)
+		return k.checkBadSignatureEvidenceInternal(ctx, subject, evidence)
 
 	default:
 		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("Bad signature must be over a batch, valset, or logic call got %s", subject))
 	}
 }
 
-func (k Keeper) checkBadSignatureEvidenceInternal(ctx sdk.Context, subject types.EthereumSigned, signature string) error {
+func (k Keeper) checkBadSignatureEvidenceInternal(ctx sdk.Context, subject types.EthereumSigned, evidence *types.BadEthSignatureEvidence) error {
+	signature := evidence.Signature
+
+	// The infraction is dated to when the subject's nonce was issued, the evidence height is chosen by the
+	// submitter and can't be trusted. A signature for a nonce that has never been issued can't be dated
+	// earlier than now
+	infractionHeight, infractionTime := ctx.BlockHeight(), ctx.BlockTime()
+	if issued := k.GetPastEthSignatureNonce(ctx, subject); issued != nil {
+		infractionHeight = int64(issued.Height)
+		infractionTime = time.Unix(0, int64(issued.BlockTime)*int64(time.Millisecond))
+	}
+
+	// Evidence is subject to the same max age as double signing evidence, it is too old once it is past
+	// both the max age in blocks and in time
+	if cp := ctx.ConsensusParams(); cp != nil && cp.Evidence != nil {
+		ageBlocks := ctx.BlockHeight() - infractionHeight
+		ageDuration := ctx.BlockTime().Sub(infractionTime)
+		if ageBlocks > cp.Evidence.MaxAgeNumBlocks && ageDuration > cp.Evidence.MaxAgeDuration {
+			return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("evidence is %d blocks and %s old, max age is %d blocks and %s", ageBlocks, ageDuration, cp.Evidence.MaxAgeNumBlocks, cp.Evidence.MaxAgeDuration))
+		}
+	}
+
 	// Get checkpoint of the supposed bad signature (fake valset, batch, or logic call submitted to eth)
 	gravityID := k.GetGravityID(ctx)
 	checkpoint := subject.GetCheckpoint(gravityID)
@@ -63,40 +100,173 @@ func (k Keeper) checkBadSignatureEvidenceInternal(ctx sdk.Context, subject types
 		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("signature to eth address failed with checkpoint %s and signature %s", hex.EncodeToString(checkpoint), signature))
 	}
 
+	// Evidence is recorded by checkpoint and signer rather than by signature, an Ethereum signature
+	// can be rewritten into a second valid form so the signature itself can't be used to dedupe
+	if k.GetBadSignatureEvidence(ctx, checkpoint, ethAddress) != nil {
+		return sdkerrors.Wrap(types.ErrDuplicate, fmt.Sprintf("evidence for checkpoint %s signed by %s has already been processed", hex.EncodeToString(checkpoint), ethAddress))
+	}
+
 	// Find the offending validator by eth address
 	val, found := k.GetValidatorByEthAddress(ctx, ethAddress)
 	if !found {
 		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("Did not find validator for eth address %s from signature %s with checkpoint %s and GravityID %s", ethAddress, signature, hex.EncodeToString(checkpoint), gravityID))
 	}
 
+	sender, err := sdk.AccAddressFromBech32(evidence.Sender)
+	if err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+
 	// Slash the offending validator
 	cons, err := val.GetConsAddr()
 	if err != nil {
 		return sdkerrors.Wrap(err, "Could not get consensus key address for validator")
 	}
 
+	if k.SlashingKeeper.IsTombstoned(ctx, cons) {
+		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("validator %s is already tombstoned", val.GetOperator()))
+	}
+
 	params := k.GetParams(ctx)
-	k.StakingKeeper.Slash(ctx, cons, ctx.BlockHeight(), val.ConsensusPower(), params.SlashFractionBadEthSignature)
+	// Slashing from the infraction height also slashes stake that has started unbonding or redelegating since,
+	// everything slashed is burned from the staking pools
+	burnedBefore := k.stakingPoolBalance(ctx)
+	k.StakingKeeper.Slash(ctx, cons, infractionHeight, val.ConsensusPower(), params.SlashFractionBadEthSignature)
+	burned := burnedBefore.Sub(k.stakingPoolBalance(ctx))
+	bounty, err := k.payBadSignatureEvidenceBounty(ctx, sender, burned, params)
+	if err != nil {
+		return err
+	}
+	k.EmitTypedEvent(ctx, &types.EventValidatorSlashed{
+		Validator:        val.GetOperator().String(),
+		Reason:           types.SLASHING_REASON_BAD_ETH_SIGNATURE,
+		SlashFraction:    params.SlashFractionBadEthSignature,
+		InfractionHeight: infractionHeight,
+	})
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, types.MetricKeySlashes},
+		1,
+		[]metrics.Label{telemetry.NewLabel(types.MetricLabelReason, types.SLASHING_REASON_BAD_ETH_SIGNATURE.String())},
+	)
 	if !val.IsJailed() {
 		k.StakingKeeper.Jail(ctx, cons)
+		k.EmitTypedEvent(ctx, &types.EventValidatorJailed{
+			Validator: val.GetOperator().String(),
+			Reason:    types.SLASHING_REASON_BAD_ETH_SIGNATURE,
+		})
 	}
+	// An Ethereum key that signs fraudulent checkpoints can't be trusted again, so the validator is
+	// removed for good like a double signer
+	k.SlashingKeeper.JailUntil(ctx, cons, evidencetypes.DoubleSignJailEndTime)
+	k.SlashingKeeper.Tombstone(ctx, cons)
+
+	k.SetBadSignatureEvidence(ctx, checkpoint, types.BadSignatureEvidence{
+		Subject:          evidence.Subject,
+		Signature:        evidence.Signature,
+		EthereumSigner:   ethAddress,
+		Validator:        val.GetOperator().String(),
+		Sender:           evidence.Sender,
+		Height:           uint64(ctx.BlockHeight()),
+		SlashedAmount:    burned,
+		Bounty:           bounty,
+		InfractionHeight: uint64(infractionHeight),
+	})
+	telemetry.IncrCounter(1, types.ModuleName, types.MetricKeyEvidenceSubmissions)
 
 	return nil
 }
 
+// payBadSignatureEvidenceBounty pays the sender of bad signature evidence the BadSignatureEvidenceBounty share
+// of the tokens burned by the validator's slash. The staking module burns slashed tokens itself, so the bounty
+// is minted back out of the burned total and the supply only shrinks by the rest
+func (k Keeper) payBadSignatureEvidenceBounty(ctx sdk.Context, sender sdk.AccAddress, burned sdk.Int, params types.Params) (sdk.Coin, error) {
+	bounty := sdk.NewCoin(k.StakingKeeper.GetParams(ctx).BondDenom, params.BadSignatureEvidenceBounty.MulInt(burned).TruncateInt())
+	if !bounty.IsPositive() {
+		return bounty, nil
+	}
+
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(bounty)); err != nil {
+		return bounty, sdkerrors.Wrap(err, "mint bounty")
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(bounty)); err != nil {
+		return bounty, sdkerrors.Wrap(err, "send bounty")
+	}
+	return bounty, nil
+}
+
+// stakingPoolBalance returns the bond denom held by the bonded and not bonded pools, which are the pools slashed
+// tokens are burned from
+func (k Keeper) stakingPoolBalance(ctx sdk.Context) sdk.Int {
+	bondDenom := k.StakingKeeper.GetParams(ctx).BondDenom
+	bonded := k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(stakingtypes.BondedPoolName)).AmountOf(bondDenom)
+	notBonded := k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(stakingtypes.NotBondedPoolName)).AmountOf(bondDenom)
+	return bonded.Add(notBonded)
+}
+
+// SetBadSignatureEvidence records processed bad signature evidence by checkpoint and Ethereum signer
+func (k Keeper) SetBadSignatureEvidence(ctx sdk.Context, checkpoint []byte, evidence types.BadSignatureEvidence) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBadSignatureEvidenceKey(checkpoint, evidence.EthereumSigner), k.cdc.MustMarshalBinaryBare(&evidence))
+}
+
+// GetBadSignatureEvidence returns the processed bad signature evidence for a checkpoint and Ethereum signer,
+// or nil if no such evidence has been submitted
+func (k Keeper) GetBadSignatureEvidence(ctx sdk.Context, checkpoint []byte, ethSigner string) *types.BadSignatureEvidence {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBadSignatureEvidenceKey(checkpoint, ethSigner))
+	if len(bz) == 0 {
+		return nil
+	}
+	var evidence types.BadSignatureEvidence
+	k.cdc.MustUnmarshalBinaryBare(bz, &evidence)
+	return &evidence
+}
+
+// GetAllBadSignatureEvidence returns all processed bad signature evidence
+func (k Keeper) GetAllBadSignatureEvidence(ctx sdk.Context) (out []types.BadSignatureEvidence) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BadSignatureEvidenceKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var evidence types.BadSignatureEvidence
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &evidence)
+		out = append(out, evidence)
+	}
+	return
+}
+
 // SetPastEthSignatureCheckpoint puts the checkpoint of a valset, batch, or logic call into a set
-// in order to prove later that it existed at one point.
-func (k Keeper) SetPastEthSignatureCheckpoint(ctx sdk.Context, checkpoint []byte) {
+// in order to prove later that it existed at one point. The height and time are recorded for its
+// nonce if this is the first checkpoint stored for it, see GetPastEthSignatureNonce
+func (k Keeper) SetPastEthSignatureCheckpoint(ctx sdk.Context, subject types.EthereumSigned) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(types.GetPastEthSignatureCheckpointKey(checkpoint), []byte{0x1})
+	store.Set(types.GetPastEthSignatureCheckpointKey(subject.GetCheckpoint(k.GetGravityID(ctx))), []byte{0x1})
+
+	nonceKey := types.GetPastEthSignatureNonceKey(subject.GetSignedNonce())
+	if !store.Has(nonceKey) {
+		store.Set(nonceKey, k.cdc.MustMarshalBinaryBare(&types.PastEthSignatureNonce{
+			Height:    uint64(ctx.BlockHeight()),
+			BlockTime: uint64(ctx.BlockTime().UnixNano() / int64(time.Millisecond)),
+		}))
+	}
 }
 
 // GetPastEthSignatureCheckpoint tells you whether a given checkpoint has ever existed
 func (k Keeper) GetPastEthSignatureCheckpoint(ctx sdk.Context, checkpoint []byte) (found bool) {
 	store := ctx.KVStore(k.storeKey)
-	if bytes.Equal(store.Get(types.GetPastEthSignatureCheckpointKey(checkpoint)), []byte{0x1}) {
-		return true
-	} else {
-		return false
+	return store.Has(types.GetPastEthSignatureCheckpointKey(checkpoint))
+}
+
+// GetPastEthSignatureNonce returns when the first checkpoint for the nonce the subject is signed for was
+// stored, or nil if the nonce has never been issued or was issued before these records were kept
+func (k Keeper) GetPastEthSignatureNonce(ctx sdk.Context, subject types.EthereumSigned) *types.PastEthSignatureNonce {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPastEthSignatureNonceKey(subject.GetSignedNonce()))
+	if len(bz) == 0 {
+		return nil
 	}
+	var issued types.PastEthSignatureNonce
+	k.cdc.MustUnmarshalBinaryBare(bz, &issued)
+	return &issued
 }
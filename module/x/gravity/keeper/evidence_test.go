@@ -10,6 +10,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 )
 
 //nolint: exhaustivestruct
@@ -123,14 +125,173 @@ func TestSubmitBadSignatureEvidenceSlash(t *testing.T) {
 	ethSignature, err := types.NewEthereumSignature(checkpoint, privKey)
 	require.NoError(t, err)
 
+	sender := AccAddrs[1]
 	msg := types.MsgSubmitBadSignatureEvidence{
 		Subject:   any,
 		Signature: hex.EncodeToString(ethSignature),
+		Sender:    sender.String(),
 	}
 
+	valBefore := input.StakingKeeper.Validator(ctx, ValAddrs[0])
+	tokensBefore := valBefore.GetTokens()
+	balanceBefore := input.BankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom)
+	supplyBefore := input.BankKeeper.GetSupply(ctx).GetTotal().AmountOf(sdk.DefaultBondDenom)
+
 	err = input.GravityKeeper.CheckBadSignatureEvidence(ctx, &msg)
 	require.NoError(t, err)
 
 	val := input.StakingKeeper.Validator(ctx, ValAddrs[0])
 	require.True(t, val.IsJailed())
+
+	// the sender is paid the bounty share of the slashed tokens
+	params := input.GravityKeeper.GetParams(ctx)
+	slashAmount := params.SlashFractionBadEthSignature.MulInt(sdk.TokensFromConsensusPower(valBefore.GetConsensusPower())).TruncateInt()
+	slashed := tokensBefore.Sub(val.GetTokens())
+	require.True(t, slashed.IsPositive())
+	require.True(t, slashed.Sub(slashAmount).ToDec().Abs().LTE(sdk.OneDec()))
+	bounty := params.BadSignatureEvidenceBounty.MulInt(slashAmount).TruncateInt()
+	require.True(t, bounty.IsPositive())
+	balanceAfter := input.BankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom)
+	require.Equal(t, bounty, balanceAfter.Amount.Sub(balanceBefore.Amount))
+
+	// the bounty comes out of the slashed tokens, only the rest is burned and nothing is minted
+	supplyAfter := input.BankKeeper.GetSupply(ctx).GetTotal().AmountOf(sdk.DefaultBondDenom)
+	require.Equal(t, slashed.Sub(bounty), supplyBefore.Sub(supplyAfter))
+
+	evidence := input.GravityKeeper.GetBadSignatureEvidence(ctx, checkpoint, ethAddress.String())
+	require.NotNil(t, evidence)
+	require.Equal(t, ValAddrs[0].String(), evidence.Validator)
+	require.Equal(t, slashed, evidence.SlashedAmount)
+	require.Equal(t, bounty, evidence.Bounty.Amount)
+	// no batch was ever issued for the nonce, so the infraction can't be dated earlier than now
+	require.Equal(t, uint64(ctx.BlockHeight()), evidence.InfractionHeight)
+	require.Len(t, input.GravityKeeper.GetAllBadSignatureEvidence(ctx), 1)
+
+	// once unjailed the same signature can not be used to punish the validator again
+	cons, err := val.GetConsAddr()
+	require.NoError(t, err)
+	input.StakingKeeper.Unjail(ctx, cons)
+	err = input.GravityKeeper.CheckBadSignatureEvidence(ctx, &msg)
+	require.True(t, types.ErrDuplicate.Is(err))
+	val = input.StakingKeeper.Validator(ctx, ValAddrs[0])
+	require.False(t, val.IsJailed())
+	require.Equal(t, tokensBefore.Sub(slashed), val.GetTokens())
+}
+
+//nolint: exhaustivestruct
+func TestBadEthSignatureEvidenceRouter(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	ethAddress := crypto.PubkeyToAddress(privKey.PublicKey)
+	input.GravityKeeper.SetEthAddressForValidator(ctx, ValAddrs[0], ethAddress.String())
+
+	// a fake valset signed for the nonce of a real one
+	valset := input.GravityKeeper.SetValsetRequest(ctx)
+	issuedHeight, issuedTime := ctx.BlockHeight(), ctx.BlockTime()
+	fake := *valset
+	fake.Members = valset.Members[:1]
+	checkpoint := fake.GetCheckpoint(input.GravityKeeper.GetGravityID(ctx))
+	any, err := codectypes.NewAnyWithValue(&fake)
+	require.NoError(t, err)
+	ethSignature, err := types.NewEthereumSignature(checkpoint, privKey)
+	require.NoError(t, err)
+
+	ctx = ctx.WithConsensusParams(&abci.ConsensusParams{
+		Evidence: &tmproto.EvidenceParams{MaxAgeNumBlocks: 100, MaxAgeDuration: time.Hour},
+	})
+	ctx = ctx.WithBlockHeight(issuedHeight + 101)
+
+	// the submitter picks the evidence height, it has no effect on the infraction height
+	evidence := &types.BadEthSignatureEvidence{
+		Subject:   any,
+		Signature: hex.EncodeToString(ethSignature),
+		Height:    ctx.BlockHeight(),
+		Sender:    AccAddrs[1].String(),
+	}
+	require.NoError(t, evidence.ValidateBasic())
+
+	// evidence older than both the max age in blocks and in time is rejected
+	err = input.EvidenceKeeper.SubmitEvidence(ctx.WithBlockTime(issuedTime.Add(time.Hour+time.Second)), evidence)
+	require.Error(t, err)
+
+	err = input.EvidenceKeeper.SubmitEvidence(ctx.WithBlockTime(issuedTime.Add(time.Hour)), evidence)
+	require.NoError(t, err)
+
+	val := input.StakingKeeper.Validator(ctx, ValAddrs[0])
+	require.True(t, val.IsJailed())
+	cons, err := val.GetConsAddr()
+	require.NoError(t, err)
+	require.True(t, input.SlashingKeeper.IsTombstoned(ctx, cons))
+
+	_, found := input.EvidenceKeeper.GetEvidence(ctx, evidence.Hash())
+	require.True(t, found)
+	record := input.GravityKeeper.GetBadSignatureEvidence(ctx, checkpoint, ethAddress.String())
+	require.NotNil(t, record)
+	require.Equal(t, uint64(issuedHeight), record.InfractionHeight)
+
+	// the same evidence at another height is still recognized as a duplicate
+	evidence.Height = ctx.BlockHeight() - 1
+	err = input.EvidenceKeeper.SubmitEvidence(ctx, evidence)
+	require.Error(t, err)
+	require.Len(t, input.GravityKeeper.GetAllBadSignatureEvidence(ctx), 1)
+}
+
+//nolint: exhaustivestruct
+func TestBadSignatureEvidenceSlashesUnbondingStake(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	ethAddress := crypto.PubkeyToAddress(privKey.PublicKey)
+	input.GravityKeeper.SetEthAddressForValidator(ctx, ValAddrs[0], ethAddress.String())
+
+	// a fake valset signed for the nonce of a real one
+	valset := input.GravityKeeper.SetValsetRequest(ctx)
+	fake := *valset
+	fake.Members = valset.Members[:1]
+	checkpoint := fake.GetCheckpoint(input.GravityKeeper.GetGravityID(ctx))
+	any, err := codectypes.NewAnyWithValue(&fake)
+	require.NoError(t, err)
+	ethSignature, err := types.NewEthereumSignature(checkpoint, privKey)
+	require.NoError(t, err)
+
+	// after the infraction half of the validator's stake starts unbonding
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	unbondAmount := StakingAmount.QuoRaw(2)
+	_, err = input.StakingKeeper.Undelegate(ctx, AccAddrs[0], ValAddrs[0], unbondAmount.ToDec())
+	require.NoError(t, err)
+
+	tokensBefore := input.StakingKeeper.Validator(ctx, ValAddrs[0]).GetTokens()
+	sender := AccAddrs[1]
+	balanceBefore := input.BankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom)
+	supplyBefore := input.BankKeeper.GetSupply(ctx).GetTotal().AmountOf(sdk.DefaultBondDenom)
+
+	err = input.GravityKeeper.CheckBadSignatureEvidence(ctx, &types.MsgSubmitBadSignatureEvidence{
+		Subject:   any,
+		Signature: hex.EncodeToString(ethSignature),
+		Sender:    sender.String(),
+	})
+	require.NoError(t, err)
+
+	// the unbonding delegation is slashed by the full fraction like the bonded stake
+	params := input.GravityKeeper.GetParams(ctx)
+	ubd, found := input.StakingKeeper.GetUnbondingDelegation(ctx, AccAddrs[0], ValAddrs[0])
+	require.True(t, found)
+	unbondingSlashed := unbondAmount.Sub(ubd.Entries[0].Balance)
+	require.Equal(t, params.SlashFractionBadEthSignature.MulInt(unbondAmount).TruncateInt(), unbondingSlashed)
+	slashed := tokensBefore.Sub(input.StakingKeeper.Validator(ctx, ValAddrs[0]).GetTokens()).Add(unbondingSlashed)
+
+	// and the bounty is the share of everything slashed, paid out of the burned tokens
+	bounty := params.BadSignatureEvidenceBounty.MulInt(slashed).TruncateInt()
+	balanceAfter := input.BankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom)
+	require.Equal(t, bounty, balanceAfter.Amount.Sub(balanceBefore.Amount))
+	supplyAfter := input.BankKeeper.GetSupply(ctx).GetTotal().AmountOf(sdk.DefaultBondDenom)
+	require.Equal(t, slashed.Sub(bounty), supplyBefore.Sub(supplyAfter))
+
+	record := input.GravityKeeper.GetBadSignatureEvidence(ctx, checkpoint, ethAddress.String())
+	require.NotNil(t, record)
+	require.Equal(t, slashed, record.SlashedAmount)
+	require.Equal(t, bounty, record.Bounty.Amount)
 }
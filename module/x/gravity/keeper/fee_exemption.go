@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// IsFeeExempt returns true if the transaction only holds confirms and claims of the orchestrators of bonded
+// validators which stay within their FeeExemptOrchestratorMsgs quota for the current block, and its gas limit is
+// at most FeeExemptMaxGas. The params are read with GetIfExists, this runs for every transaction and there is no
+// exemption until the params are set.
+func (k Keeper) IsFeeExempt(ctx sdk.Context, tx sdk.Tx) bool {
+	var quota, maxGas uint64
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreFeeExemptOrchestratorMsgs, &quota)
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreFeeExemptMaxGas, &maxGas)
+	if quota == 0 {
+		return false
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || feeTx.GetGas() > maxGas {
+		return false
+	}
+
+	counts, ok := orchestratorMsgCounts(tx.GetMsgs())
+	if !ok {
+		return false
+	}
+	for _, orchestrator := range counts.orchestrators {
+		validator, found := k.GetOrchestratorValidator(ctx, orchestrator)
+		if !found || !validator.IsBonded() {
+			return false
+		}
+		if k.GetFeeExemptMsgCount(ctx, orchestrator)+counts.msgs[orchestrator.String()] > quota {
+			return false
+		}
+	}
+	return true
+}
+
+// ConsumeFeeExemptQuota adds the messages of a fee exempt transaction to the quota of their orchestrators
+func (k Keeper) ConsumeFeeExemptQuota(ctx sdk.Context, tx sdk.Tx) {
+	counts, ok := orchestratorMsgCounts(tx.GetMsgs())
+	if !ok {
+		panic("consuming fee exempt quota for a transaction that is not fee exempt")
+	}
+	store := ctx.TransientStore(k.tStoreKey)
+	for _, orchestrator := range counts.orchestrators {
+		count := k.GetFeeExemptMsgCount(ctx, orchestrator) + counts.msgs[orchestrator.String()]
+		store.Set(types.GetFeeExemptMsgCountKey(orchestrator), types.UInt64Bytes(count))
+	}
+}
+
+// GetFeeExemptMsgCount returns the number of fee exempt messages the orchestrator submitted in the current block
+func (k Keeper) GetFeeExemptMsgCount(ctx sdk.Context, orchestrator sdk.AccAddress) uint64 {
+	bytes := ctx.TransientStore(k.tStoreKey).Get(types.GetFeeExemptMsgCountKey(orchestrator))
+	if len(bytes) == 0 {
+		return 0
+	}
+	return types.UInt64FromBytes(bytes)
+}
+
+// orchestratorMsgs holds the number of orchestrator messages of a transaction, claims in a MsgSubmitClaims
+// are counted individually
+type orchestratorMsgs struct {
+	orchestrators []sdk.AccAddress
+	msgs          map[string]uint64
+}
+
+// orchestratorMsgCounts counts the messages per orchestrator, it returns false if any message is not
+// an orchestrator confirm or claim
+func orchestratorMsgCounts(msgs []sdk.Msg) (orchestratorMsgs, bool) {
+	counts := orchestratorMsgs{msgs: make(map[string]uint64)}
+	if len(msgs) == 0 {
+		return counts, false
+	}
+	for _, msg := range msgs {
+		n := uint64(1)
+		switch msg := msg.(type) {
+		case *types.MsgSubmitClaims:
+			n = uint64(len(msg.Claims))
+		case *types.MsgValsetConfirm, *types.MsgConfirmBatch, *types.MsgConfirmLogicCall, types.EthereumClaim:
+		default:
+			return counts, false
+		}
+
+		// this runs before ValidateBasic, so the orchestrator is read directly instead of through GetClaimer
+		orchMsg, ok := msg.(interface{ GetOrchestrator() string })
+		if !ok {
+			return counts, false
+		}
+		addr, err := sdk.AccAddressFromBech32(orchMsg.GetOrchestrator())
+		if err != nil {
+			return counts, false
+		}
+		if _, seen := counts.msgs[addr.String()]; !seen {
+			counts.orchestrators = append(counts.orchestrators, addr)
+		}
+		counts.msgs[addr.String()] += n
+	}
+	return counts, true
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+//nolint: exhaustivestruct
+func TestFeeExemption(t *testing.T) {
+	input, ctx := SetupTestChain(t, 2)
+	k := input.GravityKeeper
+	validator := input.StakingKeeper.GetBondedValidatorsByPower(ctx)[0]
+	orch := sdk.AccAddress(validator.GetOperator())
+	newTx := func(msgs ...sdk.Msg) sdk.Tx {
+		return legacytx.NewStdTx(msgs, legacytx.StdFee{}, nil, "")
+	}
+	deposit := func(nonce uint64) *types.MsgSendToCosmosClaim {
+		return &types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			TokenContract:  "0x0bc529c00C6401aEF6D220BE8C6Ea1667F6Ad93e",
+			Amount:         sdk.NewInt(100),
+			EthereumSender: EthAddrs[0].String(),
+			CosmosReceiver: AccAddrs[0].String(),
+			Orchestrator:   orch.String(),
+		}
+	}
+
+	params := k.GetParams(ctx)
+	params.FeeExemptOrchestratorMsgs = 3
+	k.SetParams(ctx, params)
+
+	// only orchestrator messages of registered orchestrators are exempt
+	confirm := &types.MsgValsetConfirm{Nonce: 1, Orchestrator: orch.String()}
+	assert.True(t, k.IsFeeExempt(ctx, newTx(confirm)))
+	assert.False(t, k.IsFeeExempt(ctx, newTx(confirm, &types.MsgSendToEth{Sender: orch.String()})))
+	assert.False(t, k.IsFeeExempt(ctx, newTx(&types.MsgValsetConfirm{Nonce: 1, Orchestrator: AccAddrs[0].String()})))
+	assert.False(t, k.IsFeeExempt(ctx, newTx()))
+
+	// every claim of a MsgSubmitClaims counts towards the quota
+	claims, err := types.NewMsgSubmitClaims(orch, deposit(1), deposit(2))
+	require.NoError(t, err)
+	tx := newTx(claims)
+	require.True(t, k.IsFeeExempt(ctx, tx))
+	k.ConsumeFeeExemptQuota(ctx, tx)
+	assert.Equal(t, uint64(2), k.GetFeeExemptMsgCount(ctx, orch))
+	assert.False(t, k.IsFeeExempt(ctx, newTx(confirm, deposit(3))))
+	assert.True(t, k.IsFeeExempt(ctx, newTx(confirm)))
+
+	// zero disables the exemption
+	params.FeeExemptOrchestratorMsgs = 0
+	k.SetParams(ctx, params)
+	assert.False(t, k.IsFeeExempt(ctx, newTx(confirm)))
+}
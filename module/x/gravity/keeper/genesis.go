@@ -62,6 +62,7 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		k.SetAttestation(ctx, claim.GetEventNonce(), claim.ClaimHash(), &att)
 	}
 	k.setLastObservedEventNonce(ctx, data.LastObservedNonce)
+	k.setLastObservedEthereumHeightNonce(ctx, data.LastObservedEthereumHeightNonce)
 
 	// reset attestation state of specific validators
 	// this must be done after the above to be correct
@@ -119,6 +120,30 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		k.setCosmosOriginatedDenomToERC20(ctx, item.Denom, item.Erc20)
 	}
 
+	// reset suspended tokens in state
+	for _, token := range data.SuspendedTokens {
+		k.setTokenSuspended(ctx, token)
+	}
+
+	// reset processed bad signature evidence, the key is recomputed from the subject
+	for _, evidence := range data.BadSignatureEvidence {
+		var subject types.EthereumSigned
+		if err := k.cdc.UnpackAny(evidence.Subject, &subject); err != nil {
+			panic("couldn't unpack bad signature evidence subject")
+		}
+		k.SetBadSignatureEvidence(ctx, subject.GetCheckpoint(k.GetGravityID(ctx)), evidence)
+	}
+
+	// reset the archive of observed events
+	for _, record := range data.ObservedEventRecords {
+		k.SetObservedEventRecord(ctx, record)
+	}
+
+	// reset the deposits forwarded over IBC whose packets are not acknowledged yet
+	for _, forward := range data.PendingDepositForwards {
+		k.SetPendingDepositForward(ctx, forward)
+	}
+
 	// now that we have the denom-erc20 mapping we need to validate
 	// that the valset reward is possible and cosmos originated remove
 	// this if you want a non-cosmos originated reward
@@ -149,6 +174,11 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		lastobserved       = k.GetLastObservedEventNonce(ctx)
 		erc20ToDenoms      = []*types.ERC20ToDenom{}
 		unbatchedTransfers = k.GetUnbatchedTransactions(ctx)
+		suspendedTokens    = k.GetSuspendedTokens(ctx)
+		lastHeightNonce    = k.GetLastObservedEthereumHeightNonce(ctx)
+		badSigEvidence     = k.GetAllBadSignatureEvidence(ctx)
+		observedEvents     = k.GetObservedEventRecords(ctx)
+		pendingForwards    = k.GetPendingDepositForwards(ctx)
 	)
 
 	// export valset confirmations from state
@@ -196,5 +226,11 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		DelegateKeys:       delegates,
 		Erc20ToDenoms:      erc20ToDenoms,
 		UnbatchedTransfers: unbatchedTransfers,
+		SuspendedTokens:    suspendedTokens,
+
+		LastObservedEthereumHeightNonce: lastHeightNonce,
+		BadSignatureEvidence:            badSigEvidence,
+		ObservedEventRecords:            observedEvents,
+		PendingDepositForwards:          pendingForwards,
 	}
 }
@@ -2,9 +2,12 @@ package keeper
 
 import (
 	"context"
+	"encoding/hex"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
@@ -13,6 +16,7 @@ import (
 var _ types.QueryServer = Keeper{
 	StakingKeeper:      nil,
 	storeKey:           nil,
+	tStoreKey:          nil,
 	paramSpace:         paramstypes.Subspace{},
 	cdc:                nil,
 	bankKeeper:         nil,
@@ -60,26 +64,52 @@ func (k Keeper) ValsetConfirmsByNonce(
 	c context.Context,
 	req *types.QueryValsetConfirmsByNonceRequest) (*types.QueryValsetConfirmsByNonceResponse, error) {
 	var confirms []*types.MsgValsetConfirm
-	k.IterateValsetConfirmByNonce(sdk.UnwrapSDKContext(c), req.Nonce, func(_ []byte, c types.MsgValsetConfirm) bool {
-		confirms = append(confirms, &c)
-		return false
+	prefixStore := prefix.NewStore(sdk.UnwrapSDKContext(c).KVStore(k.storeKey), append(types.ValsetConfirmKey, types.UInt64Bytes(req.Nonce)...))
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(_ []byte, value []byte) error {
+		var confirm types.MsgValsetConfirm
+		if err := k.cdc.UnmarshalBinaryBare(value, &confirm); err != nil {
+			return err
+		}
+		confirms = append(confirms, &confirm)
+		return nil
 	})
-	return &types.QueryValsetConfirmsByNonceResponse{Confirms: confirms}, nil
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.QueryValsetConfirmsByNonceResponse{Confirms: confirms, Pagination: pageRes}, nil
 }
 
-// LastValsetRequests queries the LastValsetRequests of the gravity module
+// LastValsetRequests queries the latest valsets, or pages through all of them when pagination is set
 func (k Keeper) LastValsetRequests(
 	c context.Context,
 	req *types.QueryLastValsetRequestsRequest) (*types.QueryLastValsetRequestsResponse, error) {
-	valReq := k.GetValsets(sdk.UnwrapSDKContext(c))
-	valReqLen := len(valReq)
-	retLen := 0
-	if valReqLen < maxValsetRequestsReturned {
-		retLen = valReqLen
-	} else {
-		retLen = maxValsetRequestsReturned
+	ctx := sdk.UnwrapSDKContext(c)
+	if req.Pagination == nil {
+		valReq := k.GetValsets(ctx)
+		valReqLen := len(valReq)
+		retLen := 0
+		if valReqLen < maxValsetRequestsReturned {
+			retLen = valReqLen
+		} else {
+			retLen = maxValsetRequestsReturned
+		}
+		return &types.QueryLastValsetRequestsResponse{Valsets: valReq[0:retLen], Pagination: nil}, nil
 	}
-	return &types.QueryLastValsetRequestsResponse{Valsets: valReq[0:retLen]}, nil
+
+	var valsets []*types.Valset
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValsetRequestKey)
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(_ []byte, value []byte) error {
+		var valset types.Valset
+		if err := k.cdc.UnmarshalBinaryBare(value, &valset); err != nil {
+			return err
+		}
+		valsets = append(valsets, &valset)
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.QueryLastValsetRequestsResponse{Valsets: valsets, Pagination: pageRes}, nil
 }
 
 // LastPendingValsetRequestByAddr queries the LastPendingValsetRequestByAddr of the gravity module
@@ -111,11 +141,61 @@ func (k Keeper) LastPendingValsetRequestByAddr(
 	return &types.QueryLastPendingValsetRequestByAddrResponse{Valsets: pendingValsetReq}, nil
 }
 
-// BatchFees queries the batch fees from unbatched pool
+// BatchFees queries the fees the next batch of each token in the pool would have, the tokens are paged through
+// by contract and only the pool transactions of the tokens in the page are read
 func (k Keeper) BatchFees(
 	c context.Context,
 	req *types.QueryBatchFeeRequest) (*types.QueryBatchFeeResponse, error) {
-	return &types.QueryBatchFeeResponse{BatchFees: k.GetAllBatchFees(sdk.UnwrapSDKContext(c), OutgoingTxBatchSize)}, nil
+	ctx := sdk.UnwrapSDKContext(c)
+	pageReq := &query.PageRequest{}
+	if req.Pagination != nil {
+		*pageReq = *req.Pagination
+	}
+	if len(pageReq.Key) > 0 && pageReq.Offset > 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "either offset or key is expected, got both")
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	batchFees := []*types.BatchFees{}
+	pageRes := &query.PageResponse{}
+	var skipped, total uint64
+	for token, found := k.nextPoolToken(ctx, pageReq.Key); found; {
+		total++
+		switch {
+		case skipped < pageReq.Offset:
+			skipped++
+		case uint64(len(batchFees)) < limit:
+			batchFees = append(batchFees, k.GetBatchFeeByTokenType(ctx, token, OutgoingTxBatchSize))
+		case pageRes.NextKey == nil:
+			pageRes.NextKey = []byte(token)
+		}
+		if pageRes.NextKey != nil && !pageReq.CountTotal {
+			break
+		}
+		// skip the rest of this token's transactions
+		_, end := prefixRange([]byte(token))
+		token, found = k.nextPoolToken(ctx, end)
+	}
+	if pageReq.CountTotal {
+		pageRes.Total = total
+	}
+	return &types.QueryBatchFeeResponse{BatchFees: batchFees, Pagination: pageRes}, nil
+}
+
+// nextPoolToken returns the first token contract, starting from the given one, that has transactions in the pool
+func (k Keeper) nextPoolToken(ctx sdk.Context, from []byte) (string, bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.OutgoingTXPoolKey)
+	iter := prefixStore.Iterator(from, nil)
+	defer iter.Close()
+	if !iter.Valid() {
+		return "", false
+	}
+	var tx types.OutgoingTransferTx
+	k.cdc.MustUnmarshalBinaryBare(iter.Value(), &tx)
+	return tx.Erc20Fee.Contract, true
 }
 
 // LastPendingBatchRequestByAddr queries the LastPendingBatchRequestByAddr of the gravity module
@@ -161,16 +241,32 @@ func (k Keeper) LastPendingLogicCallByAddr(
 	return &types.QueryLastPendingLogicCallByAddrResponse{Call: pendingLogicReq}, nil
 }
 
-// OutgoingTxBatches queries the OutgoingTxBatches of the gravity module
+// OutgoingTxBatches queries the OutgoingTxBatches of the gravity module, optionally of a single token
 func (k Keeper) OutgoingTxBatches(
 	c context.Context,
 	req *types.QueryOutgoingTxBatchesRequest) (*types.QueryOutgoingTxBatchesResponse, error) {
+	storePrefix := types.OutgoingTXBatchKey
+	if req.TokenContract != "" {
+		if err := types.ValidateEthAddress(req.TokenContract); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		storePrefix = append(storePrefix, []byte(req.TokenContract)...)
+	}
+
 	var batches []*types.OutgoingTxBatch
-	k.IterateOutgoingTXBatches(sdk.UnwrapSDKContext(c), func(_ []byte, batch *types.OutgoingTxBatch) bool {
-		batches = append(batches, batch)
-		return len(batches) == MaxResults
+	prefixStore := prefix.NewStore(sdk.UnwrapSDKContext(c).KVStore(k.storeKey), storePrefix)
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(_ []byte, value []byte) error {
+		var batch types.OutgoingTxBatch
+		if err := k.cdc.UnmarshalBinaryBare(value, &batch); err != nil {
+			return err
+		}
+		batches = append(batches, &batch)
+		return nil
 	})
-	return &types.QueryOutgoingTxBatchesResponse{Batches: batches}, nil
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.QueryOutgoingTxBatchesResponse{Batches: batches, Pagination: pageRes}, nil
 }
 
 // OutgoingLogicCalls queries the OutgoingLogicCalls of the gravity module
@@ -178,11 +274,19 @@ func (k Keeper) OutgoingLogicCalls(
 	c context.Context,
 	req *types.QueryOutgoingLogicCallsRequest) (*types.QueryOutgoingLogicCallsResponse, error) {
 	var calls []*types.OutgoingLogicCall
-	k.IterateOutgoingLogicCalls(sdk.UnwrapSDKContext(c), func(_ []byte, call *types.OutgoingLogicCall) bool {
-		calls = append(calls, call)
-		return len(calls) == MaxResults
+	prefixStore := prefix.NewStore(sdk.UnwrapSDKContext(c).KVStore(k.storeKey), types.KeyOutgoingLogicCall)
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(_ []byte, value []byte) error {
+		var call types.OutgoingLogicCall
+		if err := k.cdc.UnmarshalBinaryBare(value, &call); err != nil {
+			return err
+		}
+		calls = append(calls, &call)
+		return nil
 	})
-	return &types.QueryOutgoingLogicCallsResponse{Calls: calls}, nil
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.QueryOutgoingLogicCallsResponse{Calls: calls, Pagination: pageRes}, nil
 }
 
 // BatchRequestByNonce queries the BatchRequestByNonce of the gravity module
@@ -204,12 +308,20 @@ func (k Keeper) BatchConfirms(
 	c context.Context,
 	req *types.QueryBatchConfirmsRequest) (*types.QueryBatchConfirmsResponse, error) {
 	var confirms []*types.MsgConfirmBatch
-	k.IterateBatchConfirmByNonceAndTokenContract(sdk.UnwrapSDKContext(c),
-		req.Nonce, req.ContractAddress, func(_ []byte, c types.MsgConfirmBatch) bool {
-			confirms = append(confirms, &c)
-			return false
-		})
-	return &types.QueryBatchConfirmsResponse{Confirms: confirms}, nil
+	storePrefix := append(append(types.BatchConfirmKey, []byte(req.ContractAddress)...), types.UInt64Bytes(req.Nonce)...)
+	prefixStore := prefix.NewStore(sdk.UnwrapSDKContext(c).KVStore(k.storeKey), storePrefix)
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(_ []byte, value []byte) error {
+		var confirm types.MsgConfirmBatch
+		if err := k.cdc.UnmarshalBinaryBare(value, &confirm); err != nil {
+			return err
+		}
+		confirms = append(confirms, &confirm)
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.QueryBatchConfirmsResponse{Confirms: confirms, Pagination: pageRes}, nil
 }
 
 // LogicConfirms returns the Logic confirmations by nonce and token contract
@@ -217,13 +329,20 @@ func (k Keeper) LogicConfirms(
 	c context.Context,
 	req *types.QueryLogicConfirmsRequest) (*types.QueryLogicConfirmsResponse, error) {
 	var confirms []*types.MsgConfirmLogicCall
-	k.IterateLogicConfirmByInvalidationIDAndNonce(sdk.UnwrapSDKContext(c), req.InvalidationId,
-		req.InvalidationNonce, func(_ []byte, c *types.MsgConfirmLogicCall) bool {
-			confirms = append(confirms, c)
-			return false
-		})
-
-	return &types.QueryLogicConfirmsResponse{Confirms: confirms}, nil
+	storePrefix := append(append(types.KeyOutgoingLogicConfirm, req.InvalidationId...), types.UInt64Bytes(req.InvalidationNonce)...)
+	prefixStore := prefix.NewStore(sdk.UnwrapSDKContext(c).KVStore(k.storeKey), storePrefix)
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(_ []byte, value []byte) error {
+		var confirm types.MsgConfirmLogicCall
+		if err := k.cdc.UnmarshalBinaryBare(value, &confirm); err != nil {
+			return err
+		}
+		confirms = append(confirms, &confirm)
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.QueryLogicConfirmsResponse{Confirms: confirms, Pagination: pageRes}, nil
 }
 
 // LastEventNonceByAddr returns the last event nonce for the given validator address,
@@ -272,18 +391,57 @@ func (k Keeper) ERC20ToDenom(
 	return &ret, nil
 }
 
-// GetAttestations queries the attestation map
+// GetAttestations queries the attestations by event nonce range and claim type
 func (k Keeper) GetAttestations(
 	c context.Context,
 	req *types.QueryAttestationsRequest) (*types.QueryAttestationsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	limit := req.Limit
-	if limit > QUERY_ATTESTATIONS_LIMIT {
-		limit = QUERY_ATTESTATIONS_LIMIT
+	if req.EndNonce != 0 && req.EndNonce < req.StartNonce {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "end nonce before start nonce")
+	}
+	pageReq := &query.PageRequest{}
+	if req.Pagination != nil {
+		*pageReq = *req.Pagination
+	} else {
+		pageReq.Limit = req.Limit
+	}
+	if pageReq.Limit > QUERY_ATTESTATIONS_LIMIT {
+		pageReq.Limit = QUERY_ATTESTATIONS_LIMIT
+	}
+	// attestations are stored by nonce, the first page can skip straight to the start nonce
+	if len(pageReq.Key) == 0 && pageReq.Offset == 0 && req.StartNonce > 0 {
+		pageReq.Key = types.UInt64Bytes(req.StartNonce)
 	}
-	attestations := k.GetMostRecentAttestations(ctx, limit)
 
-	return &types.QueryAttestationsResponse{Attestations: attestations}, nil
+	var attestations []*types.Attestation
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.OracleAttestationKey)
+	pageRes, err := query.FilteredPaginate(prefixStore, pageReq, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		nonce := types.UInt64FromBytes(key[:8])
+		if nonce < req.StartNonce || (req.EndNonce != 0 && nonce > req.EndNonce) {
+			return false, nil
+		}
+		var att types.Attestation
+		if err := k.cdc.UnmarshalBinaryBare(value, &att); err != nil {
+			return false, err
+		}
+		if req.ClaimType != types.CLAIM_TYPE_UNSPECIFIED {
+			claim, err := k.UnpackAttestationClaim(&att)
+			if err != nil {
+				return false, err
+			}
+			if claim.GetType() != req.ClaimType {
+				return false, nil
+			}
+		}
+		if accumulate {
+			attestations = append(attestations, &att)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.QueryAttestationsResponse{Attestations: attestations, Pagination: pageRes}, nil
 }
 
 func (k Keeper) GetDelegateKeyByValidator(
@@ -352,29 +510,327 @@ func (k Keeper) GetDelegateKeyByEth(
 	return nil, sdkerrors.Wrap(types.ErrInvalid, "No validator")
 }
 
+// GetPendingSendToEth queries the transfers to Ethereum that are not executed yet, of a single sender or of all
+// of them, the unbatched transfers are paged through in the order of the pool and the transfers in batches by
+// the batches holding them
 func (k Keeper) GetPendingSendToEth(
 	c context.Context,
 	req *types.QueryPendingSendToEth) (*types.QueryPendingSendToEthResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	batches := k.GetOutgoingTxBatches(ctx)
-	unbatched_tx := k.GetUnbatchedTransactions(ctx)
 	sender_address := req.GetSenderAddress()
 	res := types.QueryPendingSendToEthResponse{
 		TransfersInBatches: []*types.OutgoingTransferTx{},
 		UnbatchedTransfers: []*types.OutgoingTransferTx{},
+		Pagination:         nil,
+		BatchesPagination:  nil,
 	}
-	for _, batch := range batches {
+
+	batchStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.OutgoingTXBatchKey)
+	batchesPageRes, err := query.FilteredPaginate(batchStore, req.BatchesPagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var batch types.OutgoingTxBatch
+		if err := k.cdc.UnmarshalBinaryBare(value, &batch); err != nil {
+			return false, err
+		}
+		var txs []*types.OutgoingTransferTx
 		for _, tx := range batch.Transactions {
-			if tx.Sender == sender_address {
-				res.TransfersInBatches = append(res.TransfersInBatches, tx)
+			if sender_address == "" || tx.Sender == sender_address {
+				txs = append(txs, tx)
+			}
+		}
+		if len(txs) == 0 {
+			return false, nil
+		}
+		if accumulate {
+			res.TransfersInBatches = append(res.TransfersInBatches, txs...)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	res.BatchesPagination = batchesPageRes
+
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.OutgoingTXPoolKey)
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var tx types.OutgoingTransferTx
+		if err := k.cdc.UnmarshalBinaryBare(value, &tx); err != nil {
+			return false, err
+		}
+		if sender_address != "" && tx.Sender != sender_address {
+			return false, nil
+		}
+		if accumulate {
+			res.UnbatchedTransfers = append(res.UnbatchedTransfers, &tx)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	res.Pagination = pageRes
+
+	return &res, nil
+}
+
+// LastObservedEthereumHeight queries the last observed Ethereum block height and Ethereum height claim nonce
+func (k Keeper) LastObservedEthereumHeight(
+	c context.Context,
+	req *types.QueryLastObservedEthereumHeightRequest) (*types.QueryLastObservedEthereumHeightResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryLastObservedEthereumHeightResponse{
+		Height:      k.GetLastObservedEthereumBlockHeight(ctx),
+		HeightNonce: k.GetLastObservedEthereumHeightNonce(ctx),
+	}, nil
+}
+
+// OrchestratorVersions queries the orchestrator version every validator last reported
+func (k Keeper) OrchestratorVersions(
+	c context.Context,
+	req *types.QueryOrchestratorVersionsRequest) (*types.QueryOrchestratorVersionsResponse, error) {
+	var versions []types.OrchestratorVersion
+	prefixStore := prefix.NewStore(sdk.UnwrapSDKContext(c).KVStore(k.storeKey), types.OrchestratorVersionKey)
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(_ []byte, value []byte) error {
+		var version types.OrchestratorVersion
+		if err := k.cdc.UnmarshalBinaryBare(value, &version); err != nil {
+			return err
+		}
+		versions = append(versions, version)
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.QueryOrchestratorVersionsResponse{Versions: versions, Pagination: pageRes}, nil
+}
+
+// BlockTimes queries the Cosmos and Ethereum block times used to compute batch timeouts along with the
+// measurements they are derived from
+func (k Keeper) BlockTimes(
+	c context.Context,
+	req *types.QueryBlockTimesRequest) (*types.QueryBlockTimesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryBlockTimesResponse{
+		CosmosBlockTime:     k.GetCosmosBlockTime(ctx),
+		EthereumBlockTime:   k.GetEthereumBlockTime(ctx),
+		CosmosMeasurement:   k.GetCosmosBlockTimeMeasurement(ctx),
+		EthereumMeasurement: k.GetEthereumBlockTimeMeasurement(ctx),
+	}, nil
+}
+
+// BadSignatureEvidence queries the bad signature evidence that has been processed
+func (k Keeper) BadSignatureEvidence(
+	c context.Context,
+	req *types.QueryBadSignatureEvidenceRequest) (*types.QueryBadSignatureEvidenceResponse, error) {
+	var evidence []types.BadSignatureEvidence
+	prefixStore := prefix.NewStore(sdk.UnwrapSDKContext(c).KVStore(k.storeKey), types.BadSignatureEvidenceKey)
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(_ []byte, value []byte) error {
+		var e types.BadSignatureEvidence
+		if err := k.cdc.UnmarshalBinaryBare(value, &e); err != nil {
+			return err
+		}
+		evidence = append(evidence, e)
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.QueryBadSignatureEvidenceResponse{Evidence: evidence, Pagination: pageRes}, nil
+}
+
+// ObservedEvents queries the archive of observed events by event nonce range and claim type
+func (k Keeper) ObservedEvents(
+	c context.Context,
+	req *types.QueryObservedEventsRequest) (*types.QueryObservedEventsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if req.EndNonce != 0 && req.EndNonce < req.StartNonce {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "end nonce before start nonce")
+	}
+	pageReq := &query.PageRequest{}
+	if req.Pagination != nil {
+		*pageReq = *req.Pagination
+	}
+	// records are stored by nonce, the first page can skip straight to the start nonce
+	if len(pageReq.Key) == 0 && pageReq.Offset == 0 && req.StartNonce > 0 {
+		pageReq.Key = types.UInt64Bytes(req.StartNonce)
+	}
+
+	var records []types.ObservedEventRecord
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ObservedEventRecordKey)
+	pageRes, err := query.FilteredPaginate(prefixStore, pageReq, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		nonce := types.UInt64FromBytes(key)
+		if nonce < req.StartNonce || (req.EndNonce != 0 && nonce > req.EndNonce) {
+			return false, nil
+		}
+		var record types.ObservedEventRecord
+		if err := k.cdc.UnmarshalBinaryBare(value, &record); err != nil {
+			return false, err
+		}
+		if req.ClaimType != types.CLAIM_TYPE_UNSPECIFIED && record.ClaimType != req.ClaimType {
+			return false, nil
+		}
+		if accumulate {
+			records = append(records, record)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.QueryObservedEventsResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// AttestationDetail queries the competing claims at an event nonce, who voted for them with what power, the
+// bonded validators that did not vote and the threshold in effect
+func (k Keeper) AttestationDetail(
+	c context.Context,
+	req *types.QueryAttestationDetailRequest) (*types.QueryAttestationDetailResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	threshold := k.GetAttestationThreshold(ctx)
+	members, totalPower, requiredPower := threshold.Members, threshold.TotalPower, threshold.RequiredPower
+
+	voted := make(map[string]bool)
+	claims := []types.AttestationClaimDetail{}
+	for _, att := range k.GetAttestationsByNonce(ctx, req.EventNonce) {
+		att := att
+		claim, err := k.UnpackAttestationClaim(&att)
+		if err != nil {
+			return nil, sdkerrors.Wrap(types.ErrInvalid, "attestation claim")
+		}
+		details := make(map[string]types.AttestationVote, len(att.VoteDetails))
+		for _, vote := range att.VoteDetails {
+			details[vote.Validator] = vote
+		}
+		detail := types.AttestationClaimDetail{
+			ClaimHash:    claim.ClaimHash(),
+			Claim:        att.Claim,
+			Observed:     att.Observed,
+			Votes:        make([]types.AttestationVote, 0, len(att.Votes)),
+			VotePower:    0,
+			CountedPower: 0,
+		}
+		for _, voter := range att.Votes {
+			voted[voter] = true
+			// votes cast before vote details were recorded have no height or power
+			vote, found := details[voter]
+			if !found {
+				vote = types.AttestationVote{Validator: voter, Height: 0, Power: 0}
+			}
+			detail.Votes = append(detail.Votes, vote)
+			detail.VotePower += vote.Power
+
+			val, err := sdk.ValAddressFromBech32(voter)
+			if err != nil {
+				return nil, sdkerrors.Wrap(types.ErrInvalid, "voter")
+			}
+			if members == nil || members[voter] {
+				detail.CountedPower += k.StakingKeeper.GetLastValidatorPower(ctx, val)
 			}
 		}
+		claims = append(claims, detail)
 	}
-	for _, tx := range unbatched_tx {
-		if tx.Sender == sender_address {
-			res.UnbatchedTransfers = append(res.UnbatchedTransfers, tx)
+
+	missing := []string{}
+	for _, val := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+		if operator := val.GetOperator().String(); !voted[operator] {
+			missing = append(missing, operator)
 		}
 	}
 
+	return &types.QueryAttestationDetailResponse{
+		Claims:            claims,
+		MissingValidators: missing,
+		TotalPower:        totalPower,
+		RequiredPower:     requiredPower,
+	}, nil
+}
+
+// BridgeStatus summarizes the health of the bridge in a single query: the observed Ethereum state, the
+// outstanding valsets, batches and logic calls with how much of the bridge power has signed them, and the
+// standing of every bonded validator
+func (k Keeper) BridgeStatus(
+	c context.Context,
+	req *types.QueryBridgeStatusRequest) (*types.QueryBridgeStatusResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	res := types.QueryBridgeStatusResponse{
+		LastObservedEventNonce:     k.GetLastObservedEventNonce(ctx),
+		LastObservedEthereumHeight: k.GetLastObservedEthereumBlockHeight(ctx),
+		LatestValsetNonce:          k.GetLatestValsetNonce(ctx),
+		LastObservedValsetNonce:    0,
+		PendingBatches:             []types.PendingBatchStatus{},
+		PendingLogicCalls:          []types.PendingLogicCallStatus{},
+		Validators:                 []types.ValidatorBridgeStatus{},
+		SuspendedTokens:            k.GetSuspendedTokens(ctx),
+	}
+	if valset := k.GetLastObservedValset(ctx); valset != nil {
+		res.LastObservedValsetNonce = valset.Nonce
+	}
+
+	threshold := k.GetAttestationThreshold(ctx)
+	members, totalPower := threshold.Members, threshold.TotalPower
+	for _, batch := range k.GetOutgoingTxBatches(ctx) {
+		var signers []string
+		for _, confirm := range k.GetBatchConfirmByNonceAndTokenContract(ctx, batch.BatchNonce, batch.TokenContract) {
+			signers = append(signers, confirm.Orchestrator)
+		}
+		res.PendingBatches = append(res.PendingBatches, types.PendingBatchStatus{
+			TokenContract:  batch.TokenContract,
+			BatchNonce:     batch.BatchNonce,
+			BatchTimeout:   batch.BatchTimeout,
+			ConfirmedPower: k.confirmedPowerPercentage(ctx, members, totalPower, signers),
+		})
+	}
+	for _, call := range k.GetOutgoingLogicCalls(ctx) {
+		var signers []string
+		for _, confirm := range k.GetLogicConfirmByInvalidationIDAndNonce(ctx, call.InvalidationId, call.InvalidationNonce) {
+			signers = append(signers, confirm.Orchestrator)
+		}
+		res.PendingLogicCalls = append(res.PendingLogicCalls, types.PendingLogicCallStatus{
+			InvalidationId:    hex.EncodeToString(call.InvalidationId),
+			InvalidationNonce: call.InvalidationNonce,
+			Timeout:           call.Timeout,
+			ConfirmedPower:    k.confirmedPowerPercentage(ctx, members, totalPower, signers),
+		})
+	}
+
+	orchestrators := make(map[string]string)
+	for _, keys := range k.GetDelegateKeys(ctx) {
+		orchestrators[keys.Validator] = keys.Orchestrator
+	}
+	for _, val := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+		operator := val.GetOperator()
+		ethAddress, found := k.GetEthAddressByValidator(ctx, operator)
+		res.Validators = append(res.Validators, types.ValidatorBridgeStatus{
+			Validator:               operator.String(),
+			Orchestrator:            orchestrators[operator.String()],
+			EthereumAddress:         ethAddress,
+			DelegateKeysSet:         found,
+			LastEventNonce:          k.GetLastEventNonceByValidator(ctx, operator),
+			MissedValsetConfirms:    k.GetConfirmLivenessInfo(ctx, types.CONFIRM_TYPE_VALSET, operator).MissedConfirmsCounter,
+			MissedBatchConfirms:     k.GetConfirmLivenessInfo(ctx, types.CONFIRM_TYPE_BATCH, operator).MissedConfirmsCounter,
+			MissedLogicCallConfirms: k.GetConfirmLivenessInfo(ctx, types.CONFIRM_TYPE_LOGIC_CALL, operator).MissedConfirmsCounter,
+		})
+	}
+
 	return &res, nil
 }
+
+// confirmedPowerPercentage returns the percentage of the bridge validator set power held by the validators of
+// the given orchestrators, only members of the set count as in GetAttestationThreshold
+func (k Keeper) confirmedPowerPercentage(ctx sdk.Context, members map[string]bool, totalPower sdk.Int, orchestrators []string) sdk.Dec {
+	if !totalPower.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	var confirmedPower int64
+	for _, orchestrator := range orchestrators {
+		orchaddr, err := sdk.AccAddressFromBech32(orchestrator)
+		if err != nil {
+			continue
+		}
+		val, found := k.GetOrchestratorValidatorAddr(ctx, orchaddr)
+		if !found || (members != nil && !members[val.String()]) {
+			continue
+		}
+		confirmedPower += k.StakingKeeper.GetLastValidatorPower(ctx, val)
+	}
+	return sdk.NewDec(confirmedPower).MulInt64(100).QuoInt(totalPower)
+}
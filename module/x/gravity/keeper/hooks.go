@@ -20,7 +20,7 @@ func (k Keeper) Hooks() Hooks {
 	return Hooks{k}
 }
 
-func (h Hooks) AfterValidatorBeginUnbonding(ctx sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) {
+func (h Hooks) AfterValidatorBeginUnbonding(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) {
 
 	// When Validator starts Unbonding, Persist the block height in the store
 	// Later in endblocker, check if there is at least one validator who started unbonding and create a valset request.
@@ -32,16 +32,37 @@ func (h Hooks) AfterValidatorBeginUnbonding(ctx sdk.Context, _ sdk.ConsAddress,
 
 	h.k.SetLastUnBondingBlockHeight(ctx, uint64(ctx.BlockHeight()))
 
+	// a validator that is no longer bonded does not need delegate keys
+	h.k.deleteValidatorAwaitingKeys(ctx, valAddr)
 }
 
 func (h Hooks) BeforeDelegationCreated(_ sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
 }
-func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress)           {}
-func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress)                 {}
-func (h Hooks) AfterValidatorBonded(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) {}
+func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) {}
+func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress)       {}
+
+func (h Hooks) AfterValidatorBonded(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) {
+
+	// A validator that bonds without delegate keys is not part of the bridge validator set, so it can't sign
+	// anything for the bridge. It is given KeyRegistrationGracePeriod blocks to register keys with
+	// MsgSetOrchestratorAddress before it is jailed in the endblocker.
+
+	if _, found := h.k.GetEthAddressByValidator(ctx, valAddr); !found {
+		h.k.setValidatorAwaitingKeys(ctx, valAddr, uint64(ctx.BlockHeight()))
+	}
+}
+
+func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) {}
+
+func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) {
+
+	// Once a validator is removed from staking its delegate keys are cleaned up, otherwise its Ethereum address
+	// and orchestrator could never be registered again
+
+	h.k.DeleteDelegateKeys(ctx, valAddr)
+	h.k.deleteValidatorAwaitingKeys(ctx, valAddr)
+}
 
-func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)        {}
-func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) {}
 func (h Hooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) {}
 func (h Hooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
 }
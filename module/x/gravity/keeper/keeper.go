@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/gogo/protobuf/proto"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
@@ -18,11 +19,16 @@ type Keeper struct {
 	StakingKeeper types.StakingKeeper
 
 	storeKey   sdk.StoreKey // Unexposed key to access store from sdk.Context
+	tStoreKey  sdk.StoreKey // Unexposed key to access the transient store from sdk.Context
 	paramSpace paramtypes.Subspace
 
 	cdc            codec.BinaryMarshaler // The wire codec for binary encoding/decoding.
 	bankKeeper     types.BankKeeper
 	SlashingKeeper types.SlashingKeeper
+	EvidenceKeeper types.EvidenceKeeper
+
+	// telemetryEnabled is true if the node reports telemetry, the bridge gauges are not computed otherwise
+	telemetryEnabled bool
 
 	AttestationHandler interface {
 		Handle(sdk.Context, types.Attestation, types.EthereumClaim) error
@@ -30,7 +36,7 @@ type Keeper struct {
 }
 
 // NewKeeper returns a new instance of the gravity keeper
-func NewKeeper(cdc codec.BinaryMarshaler, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, stakingKeeper types.StakingKeeper, bankKeeper types.BankKeeper, slashingKeeper types.SlashingKeeper) Keeper {
+func NewKeeper(cdc codec.BinaryMarshaler, storeKey sdk.StoreKey, tStoreKey sdk.StoreKey, paramSpace paramtypes.Subspace, stakingKeeper types.StakingKeeper, bankKeeper types.BankKeeper, slashingKeeper types.SlashingKeeper, transferKeeper types.TransferKeeper, channelKeeper types.ChannelKeeper) Keeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
@@ -39,20 +45,42 @@ func NewKeeper(cdc codec.BinaryMarshaler, storeKey sdk.StoreKey, paramSpace para
 	k := Keeper{
 		StakingKeeper:      stakingKeeper,
 		storeKey:           storeKey,
+		tStoreKey:          tStoreKey,
 		paramSpace:         paramSpace,
 		cdc:                cdc,
 		bankKeeper:         bankKeeper,
 		SlashingKeeper:     slashingKeeper,
+		EvidenceKeeper:     nil,
+		telemetryEnabled:   false,
 		AttestationHandler: nil,
 	}
 	k.AttestationHandler = AttestationHandler{
-		keeper:     k,
-		bankKeeper: bankKeeper,
+		keeper:         k,
+		bankKeeper:     bankKeeper,
+		transferKeeper: transferKeeper,
+		channelKeeper:  channelKeeper,
 	}
 
 	return k
 }
 
+// SetEvidenceKeeper sets the evidence keeper that MsgSubmitBadSignatureEvidence is submitted to, this can't
+// be done in NewKeeper as the evidence keeper router needs the gravity keeper to handle BadEthSignatureEvidence
+func (k *Keeper) SetEvidenceKeeper(evidenceKeeper types.EvidenceKeeper) *Keeper {
+	if k.EvidenceKeeper != nil {
+		panic("cannot set gravity evidence keeper twice")
+	}
+	k.EvidenceKeeper = evidenceKeeper
+	return k
+}
+
+// SetTelemetryEnabled tells the keeper whether the node reports telemetry, this is node configuration and must
+// not affect state
+func (k *Keeper) SetTelemetryEnabled(enabled bool) *Keeper {
+	k.telemetryEnabled = enabled
+	return k
+}
+
 /////////////////////////////
 //       PARAMETERS        //
 /////////////////////////////
@@ -117,6 +145,14 @@ func (k Keeper) logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// EmitTypedEvent emits one of the typed events in events.proto, events are not part of consensus
+// so a failure to encode one is logged rather than returned
+func (k Keeper) EmitTypedEvent(ctx sdk.Context, event proto.Message) {
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		k.logger(ctx).Error("failed to emit event", "type", proto.MessageName(event), "cause", err.Error())
+	}
+}
+
 func (k Keeper) UnpackAttestationClaim(att *types.Attestation) (types.EthereumClaim, error) {
 	var msg types.EthereumClaim
 	err := k.cdc.UnpackAny(att.Claim, &msg)
@@ -220,14 +256,26 @@ func (k Keeper) GetLastSlashedLogicCallBlock(ctx sdk.Context) uint64 {
 	return types.UInt64FromBytes(bytes)
 }
 
-// GetUnSlashedLogicCalls returns all the unslashed logic calls in state
-func (k Keeper) GetUnSlashedLogicCalls(ctx sdk.Context, maxHeight uint64) (out []*types.OutgoingLogicCall) {
+// GetUnSlashedLogicCalls returns the unslashed logic calls in state created before maxHeight in ASC order of
+// block. Logic calls are not indexed by block so every call is read, but as with batches once limit is reached
+// only the remaining calls created in the same block as the last one are included.
+func (k Keeper) GetUnSlashedLogicCalls(ctx sdk.Context, maxHeight uint64, limit uint64) (out []*types.OutgoingLogicCall) {
 	lastSlashedLogicCallBlock := k.GetLastSlashedLogicCallBlock(ctx)
-	calls := k.GetOutgoingLogicCalls(ctx)
+	var calls []*types.OutgoingLogicCall
+	k.IterateOutgoingLogicCalls(ctx, func(_ []byte, call *types.OutgoingLogicCall) bool {
+		if call.Block > lastSlashedLogicCallBlock && call.Block < maxHeight {
+			calls = append(calls, call)
+		}
+		return false
+	})
+	sort.SliceStable(calls, func(i, j int) bool {
+		return calls[i].Block < calls[j].Block
+	})
 	for _, call := range calls {
-		if call.Block > lastSlashedLogicCallBlock {
-			out = append(out, call)
+		if uint64(len(out)) >= limit && out[len(out)-1].Block != call.Block {
+			break
 		}
+		out = append(out, call)
 	}
 	return
 }
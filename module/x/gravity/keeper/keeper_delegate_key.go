@@ -4,6 +4,7 @@ import (
 	"time"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -18,6 +19,48 @@ import (
 func (k Keeper) SetOrchestratorValidator(ctx sdk.Context, val sdk.ValAddress, orch sdk.AccAddress) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.GetOrchestratorAddressKey(orch), val.Bytes())
+	store.Set(types.GetValidatorOrchestratorAddressKey(val), orch.Bytes())
+}
+
+// GetValidatorOrchestrator returns the orchestrator address a validator has registered
+func (k Keeper) GetValidatorOrchestrator(ctx sdk.Context, val sdk.ValAddress) (sdk.AccAddress, bool) {
+	store := ctx.KVStore(k.storeKey)
+	orch := store.Get(types.GetValidatorOrchestratorAddressKey(val))
+	if orch == nil {
+		return nil, false
+	}
+	return sdk.AccAddress(orch), true
+}
+
+// IndexOrchestratorValidators indexes the orchestrator of every validator that registered delegate keys before
+// orchestrators were indexed by validator, this is run once as a store migration
+func (k Keeper) IndexOrchestratorValidators(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iter := prefix.NewStore(store, types.KeyOrchestratorAddress).Iterator(nil, nil)
+	defer iter.Close()
+
+	type registration struct {
+		validator    sdk.ValAddress
+		orchestrator sdk.AccAddress
+	}
+	var registrations []registration
+	for ; iter.Valid(); iter.Next() {
+		registrations = append(registrations, registration{sdk.ValAddress(iter.Value()), sdk.AccAddress(iter.Key())})
+	}
+	for _, r := range registrations {
+		store.Set(types.GetValidatorOrchestratorAddressKey(r.validator), r.orchestrator.Bytes())
+	}
+}
+
+// GetOrchestratorValidatorAddr returns the validator address associated with an orchestrator key without
+// looking up the validator itself, this is much cheaper where only the address is needed
+func (k Keeper) GetOrchestratorValidatorAddr(ctx sdk.Context, orch sdk.AccAddress) (sdk.ValAddress, bool) {
+	store := ctx.KVStore(k.storeKey)
+	valAddr := store.Get(types.GetOrchestratorAddressKey(orch))
+	if valAddr == nil {
+		return nil, false
+	}
+	return sdk.ValAddress(valAddr), true
 }
 
 // GetOrchestratorValidator returns the validator key associated with an orchestrator key
@@ -195,3 +238,119 @@ func (k Keeper) GetValidatorByEthAddress(ctx sdk.Context, ethAddr string) (valid
 
 	return validator, true
 }
+
+// DeleteDelegateKeys removes the orchestrator and Ethereum address mappings of a validator, this frees up the
+// keys so that they can be registered again
+func (k Keeper) DeleteDelegateKeys(ctx sdk.Context, validator sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	if ethAddress, found := k.GetEthAddressByValidator(ctx, validator); found {
+		store.Delete(types.GetValidatorByEthAddressKey(ethAddress))
+		store.Delete(types.GetEthAddressByValidatorKey(validator))
+	}
+
+	if orch, found := k.GetValidatorOrchestrator(ctx, validator); found {
+		store.Delete(types.GetOrchestratorAddressKey(orch))
+		store.Delete(types.GetValidatorOrchestratorAddressKey(validator))
+	}
+}
+
+/////////////////////////////
+//   KEY REGISTRATION      //
+/////////////////////////////
+
+// setValidatorAwaitingKeys records that a validator bonded at the given height without delegate keys
+func (k Keeper) setValidatorAwaitingKeys(ctx sdk.Context, validator sdk.ValAddress, bondedHeight uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorAwaitingKeysKey(validator), types.UInt64Bytes(bondedHeight))
+}
+
+// GetValidatorAwaitingKeys returns the height a validator that has not registered delegate keys bonded at
+func (k Keeper) GetValidatorAwaitingKeys(ctx sdk.Context, validator sdk.ValAddress) (bondedHeight uint64, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorAwaitingKeysKey(validator))
+	if len(bz) == 0 {
+		return 0, false
+	}
+	return types.UInt64FromBytes(bz), true
+}
+
+// deleteValidatorAwaitingKeys removes a validator from the validators awaiting delegate keys
+func (k Keeper) deleteValidatorAwaitingKeys(ctx sdk.Context, validator sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorAwaitingKeysKey(validator))
+}
+
+// IterateValidatorsAwaitingKeys iterates through the bonded validators that have not registered delegate keys
+// along with the height they bonded at
+func (k Keeper) IterateValidatorsAwaitingKeys(ctx sdk.Context, cb func(validator sdk.ValAddress, bondedHeight uint64) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorAwaitingKeysKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		// cb returns true to stop early
+		if cb(sdk.ValAddress(iter.Key()), types.UInt64FromBytes(iter.Value())) {
+			return
+		}
+	}
+}
+
+// JailValidatorsWithoutKeys jails the validators that have not registered delegate keys within the
+// KeyRegistrationGracePeriod after bonding. Validators that have registered keys since are no longer awaited.
+// Jailed validators can't unjail for the slashing module's DowntimeJailDuration, like validators jailed for
+// downtime, so a validator can't rejoin the active set without keys straight away and restart its grace period.
+func (k Keeper) JailValidatorsWithoutKeys(ctx sdk.Context, params types.Params) {
+	type awaiting struct {
+		validator    sdk.ValAddress
+		bondedHeight uint64
+	}
+	var validators []awaiting
+	k.IterateValidatorsAwaitingKeys(ctx, func(validator sdk.ValAddress, bondedHeight uint64) bool {
+		validators = append(validators, awaiting{validator, bondedHeight})
+		return false
+	})
+
+	for _, v := range validators {
+		if _, found := k.GetEthAddressByValidator(ctx, v.validator); found {
+			k.deleteValidatorAwaitingKeys(ctx, v.validator)
+			continue
+		}
+		if uint64(ctx.BlockHeight()) < v.bondedHeight+params.KeyRegistrationGracePeriod {
+			continue
+		}
+
+		k.deleteValidatorAwaitingKeys(ctx, v.validator)
+		val, found := k.StakingKeeper.GetValidator(ctx, v.validator)
+		if !found || val.IsJailed() || !val.IsBonded() {
+			continue
+		}
+		cons, err := val.GetConsAddr()
+		if err != nil {
+			continue
+		}
+		k.StakingKeeper.Jail(ctx, cons)
+		k.SlashingKeeper.JailUntil(ctx, cons, ctx.BlockHeader().Time.Add(k.SlashingKeeper.DowntimeJailDuration(ctx)))
+
+		k.EmitTypedEvent(ctx, &types.EventValidatorJailed{
+			Validator: v.validator.String(),
+			Reason:    types.SLASHING_REASON_MISSING_DELEGATE_KEYS,
+		})
+	}
+}
+
+// SetLastKeyRegistrationBlockHeight sets the last block height a newly bonded validator registered delegate keys
+func (k Keeper) SetLastKeyRegistrationBlockHeight(ctx sdk.Context, blockHeight uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastKeyRegistrationBlockHeightKey, types.UInt64Bytes(blockHeight))
+}
+
+// GetLastKeyRegistrationBlockHeight returns the last block height a newly bonded validator registered delegate keys
+func (k Keeper) GetLastKeyRegistrationBlockHeight(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bytes := store.Get(types.LastKeyRegistrationBlockHeightKey)
+
+	if len(bytes) == 0 {
+		return 0
+	}
+	return types.UInt64FromBytes(bytes)
+}
@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"encoding/hex"
-	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -31,20 +30,39 @@ func (k Keeper) GetOutgoingLogicCall(ctx sdk.Context, invalidationID []byte, inv
 	return &call
 }
 
+// HasOutgoingLogicCall returns true if the outgoing logic call exists
+func (k Keeper) HasOutgoingLogicCall(ctx sdk.Context, invalidationID []byte, invalidationNonce uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetOutgoingLogicCallKey(invalidationID, invalidationNonce))
+}
+
 // SetOutogingLogicCall sets an outgoing logic call
 func (k Keeper) SetOutgoingLogicCall(ctx sdk.Context, call *types.OutgoingLogicCall) {
 	store := ctx.KVStore(k.storeKey)
 
 	// Store checkpoint to prove that this logic call actually happened
-	checkpoint := call.GetCheckpoint(k.GetGravityID(ctx))
-	k.SetPastEthSignatureCheckpoint(ctx, checkpoint)
+	k.SetPastEthSignatureCheckpoint(ctx, call)
 
 	store.Set(types.GetOutgoingLogicCallKey(call.InvalidationId, call.InvalidationNonce),
 		k.cdc.MustMarshalBinaryBare(call))
+
+	k.EmitTypedEvent(ctx, &types.EventOutgoingLogicCallCreated{
+		InvalidationId:       hex.EncodeToString(call.InvalidationId),
+		InvalidationNonce:    call.InvalidationNonce,
+		LogicContractAddress: call.LogicContractAddress,
+		Timeout:              call.Timeout,
+	})
 }
 
-// DeleteOutgoingLogicCall deletes outgoing logic calls
+// DeleteOutgoingLogicCall deletes outgoing logic calls, their confirms are pruned once the SignedLogicCallsWindow
+// has passed. Deleting a logic call that does not exist does nothing.
 func (k Keeper) DeleteOutgoingLogicCall(ctx sdk.Context, invalidationID []byte, invalidationNonce uint64) {
+	call := k.GetOutgoingLogicCall(ctx, invalidationID, invalidationNonce)
+	if call == nil {
+		return
+	}
+	k.scheduleConfirmPruning(ctx, call.Block+k.GetParams(ctx).SignedLogicCallsWindow,
+		types.GetLogicConfirmKey(invalidationID, invalidationNonce, nil))
 	ctx.KVStore(k.storeKey).Delete(types.GetOutgoingLogicCallKey(invalidationID, invalidationNonce))
 }
 
@@ -82,13 +100,10 @@ func (k Keeper) CancelOutgoingLogicCall(ctx sdk.Context, invalidationId []byte,
 	k.DeleteOutgoingLogicCall(ctx, call.InvalidationId, call.InvalidationNonce)
 
 	// a consuming application will have to watch for this event and act on it
-	batchEvent := sdk.NewEvent(
-		types.EventTypeOutgoingLogicCallCanceled,
-		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-		sdk.NewAttribute(types.AttributeKeyInvalidationID, fmt.Sprint(call.InvalidationId)),
-		sdk.NewAttribute(types.AttributeKeyInvalidationNonce, fmt.Sprint(call.InvalidationNonce)),
-	)
-	ctx.EventManager().EmitEvent(batchEvent)
+	k.EmitTypedEvent(ctx, &types.EventOutgoingLogicCallCanceled{
+		InvalidationId:    hex.EncodeToString(call.InvalidationId),
+		InvalidationNonce: call.InvalidationNonce,
+	})
 	return nil
 }
 
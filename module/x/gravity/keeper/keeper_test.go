@@ -3,6 +3,7 @@ package keeper
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -83,6 +84,75 @@ func TestCurrentValsetNormalization(t *testing.T) {
 	}
 }
 
+//nolint: exhaustivestruct
+func TestCurrentValsetBridgeSetLimits(t *testing.T) {
+	specs := map[string]struct {
+		maxValidators uint64
+		minPower      sdk.Dec
+		keyless       map[int]bool
+		expMembers    []int
+	}{
+		"all validators fit": {
+			maxValidators: 10,
+			minPower:      sdk.NewDecWithPrec(9, 1),
+			expMembers:    []int{1, 3, 2, 0},
+		},
+		"capped by count": {
+			maxValidators: 2,
+			minPower:      sdk.NewDecWithPrec(5, 1),
+			expMembers:    []int{1, 3},
+		},
+		"extended to reach min power": {
+			maxValidators: 2,
+			minPower:      sdk.NewDecWithPrec(9, 1),
+			expMembers:    []int{1, 3, 2},
+		},
+		"min power is a share of all bonded power": {
+			maxValidators: 1,
+			minPower:      sdk.NewDecWithPrec(6, 1),
+			keyless:       map[int]bool{1: true},
+			expMembers:    []int{3, 2, 0},
+		},
+	}
+	srcPowers := []int64{5, 50, 15, 30}
+	for msg, spec := range specs {
+		spec := spec
+		t.Run(msg, func(t *testing.T) {
+			input := CreateTestEnv(t)
+			ctx := input.Context
+			params := input.GravityKeeper.GetParams(ctx)
+			params.MaxBridgeValidators = spec.maxValidators
+			params.MinBridgePower = spec.minPower
+			input.GravityKeeper.SetParams(ctx, params)
+
+			operators := make([]MockStakingValidatorData, len(srcPowers))
+			for i, v := range srcPowers {
+				cAddr := bytes.Repeat([]byte{byte(i)}, sdk.AddrLen)
+				operators[i] = MockStakingValidatorData{
+					// any unique addr
+					Operator: cAddr,
+					Power:    v,
+				}
+				if !spec.keyless[i] {
+					input.GravityKeeper.SetEthAddressForValidator(ctx, cAddr, EthAddrs[i].String())
+				}
+			}
+			input.GravityKeeper.StakingKeeper = NewStakingKeeperWeightedMock(operators...)
+			r := input.GravityKeeper.GetCurrentValset(ctx)
+
+			require.Len(t, r.Members, len(spec.expMembers))
+			var totalPower int64
+			for i, member := range r.Members {
+				assert.Equal(t, EthAddrs[spec.expMembers[i]].String(), member.EthereumAddress)
+				totalPower += srcPowers[spec.expMembers[i]]
+			}
+			// powers are normalized over the members only
+			exp := sdk.NewUint(uint64(srcPowers[spec.expMembers[0]])).MulUint64(math.MaxUint32).QuoUint64(uint64(totalPower)).Uint64()
+			assert.Equal(t, exp, r.Members[0].Power)
+		})
+	}
+}
+
 //nolint: exhaustivestruct
 func TestAttestationIterator(t *testing.T) {
 	input := CreateTestEnv(t)
@@ -163,6 +233,29 @@ func TestDelegateKeys(t *testing.T) {
 		assert.Equal(t, ethAddrs[i], res.EthAddress)
 	}
 
+	// orchestrators registered before they were indexed by validator are indexed by the migration
+	store := ctx.KVStore(k.storeKey)
+	for i := range valAddrs {
+		val, _ := sdk.ValAddressFromBech32(valAddrs[i])
+		store.Delete(types.GetValidatorOrchestratorAddressKey(val))
+	}
+	k.IndexOrchestratorValidators(ctx)
+	for i := range valAddrs {
+		val, _ := sdk.ValAddressFromBech32(valAddrs[i])
+		orch, found := k.GetValidatorOrchestrator(ctx, val)
+		require.True(t, found)
+		assert.Equal(t, orchAddrs[i], orch.String())
+	}
+
+	// deleting the keys of one validator leaves the others alone
+	val, _ := sdk.ValAddressFromBech32(valAddrs[1])
+	k.DeleteDelegateKeys(ctx, val)
+	_, found := k.GetValidatorOrchestrator(ctx, val)
+	assert.False(t, found)
+	orch, _ := sdk.AccAddressFromBech32(orchAddrs[1])
+	_, found = k.GetOrchestratorValidatorAddr(ctx, orch)
+	assert.False(t, found)
+	assert.Len(t, k.GetDelegateKeys(ctx), len(valAddrs)-1)
 }
 
 //nolint: exhaustivestruct
@@ -186,7 +279,7 @@ func TestLastSlashedValsetNonce(t *testing.T) {
 	//  lastSlashedValsetNonce should be zero initially.
 	lastSlashedValsetNonce := k.GetLastSlashedValsetNonce(ctx)
 	assert.Equal(t, lastSlashedValsetNonce, uint64(0))
-	unslashedValsets := k.GetUnSlashedValsets(ctx, uint64(12))
+	unslashedValsets := k.GetUnSlashedValsets(ctx, uint64(12), 100)
 	assert.Equal(t, len(unslashedValsets), 9)
 
 	// check if last Slashed Valset nonce is set properly or not
@@ -197,20 +290,24 @@ func TestLastSlashedValsetNonce(t *testing.T) {
 	lastSlashedValset := k.GetValset(ctx, lastSlashedValsetNonce)
 
 	// when valset height + signedValsetsWindow > current block height, len(unslashedValsets) should be zero
-	unslashedValsets = k.GetUnSlashedValsets(ctx, uint64(ctx.BlockHeight()))
+	unslashedValsets = k.GetUnSlashedValsets(ctx, uint64(ctx.BlockHeight()), 100)
 	assert.Equal(t, len(unslashedValsets), 0)
 
 	// when lastSlashedValset height + signedValsetsWindow == BlockHeight, len(unslashedValsets) should be zero
 	heightDiff := uint64(ctx.BlockHeight()) - lastSlashedValset.Height
-	unslashedValsets = k.GetUnSlashedValsets(ctx, heightDiff)
+	unslashedValsets = k.GetUnSlashedValsets(ctx, heightDiff, 100)
 	assert.Equal(t, len(unslashedValsets), 0)
 
 	// when signedValsetsWindow is between lastSlashedValset height and latest valset's height
-	unslashedValsets = k.GetUnSlashedValsets(ctx, heightDiff-2)
+	unslashedValsets = k.GetUnSlashedValsets(ctx, heightDiff-2, 100)
 	assert.Equal(t, len(unslashedValsets), 2)
 
 	// when signedValsetsWindow > latest valset's height
-	unslashedValsets = k.GetUnSlashedValsets(ctx, heightDiff-6)
+	unslashedValsets = k.GetUnSlashedValsets(ctx, heightDiff-6, 100)
 	assert.Equal(t, len(unslashedValsets), 6)
+
+	// at most limit valsets are returned, starting from the oldest
+	limitedValsets := k.GetUnSlashedValsets(ctx, heightDiff-6, 4)
+	assert.Equal(t, unslashedValsets[:4], limitedValsets)
 	fmt.Println("unslashedValsetsRange", unslashedValsets)
 }
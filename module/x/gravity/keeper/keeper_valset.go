@@ -1,10 +1,8 @@
 package keeper
 
 import (
-	"fmt"
 	"math"
 	"sort"
-	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -27,19 +25,19 @@ func (k Keeper) SetValsetRequest(ctx sdk.Context) *types.Valset {
 	// based slashing. We are storing the checkpoint that will be signed with
 	// the validators Etheruem keys so that we know not to slash them if someone
 	// attempts to submit the signature of this validator set as evidence of bad behavior
-	checkpoint := valset.GetCheckpoint(k.GetGravityID(ctx))
-	k.SetPastEthSignatureCheckpoint(ctx, checkpoint)
-
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeMultisigUpdateRequest,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(types.AttributeKeyContract, k.GetBridgeContractAddress(ctx)),
-			sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.GetBridgeChainID(ctx)))),
-			sdk.NewAttribute(types.AttributeKeyMultisigID, fmt.Sprint(valset.Nonce)),
-			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(valset.Nonce)),
-		),
-	)
+	k.SetPastEthSignatureCheckpoint(ctx, valset)
+
+	members := make([]types.BridgeValidator, len(valset.Members))
+	for i, member := range valset.Members {
+		members[i] = *member
+	}
+	k.EmitTypedEvent(ctx, &types.EventValsetRequested{
+		ValsetNonce:    valset.Nonce,
+		Height:         valset.Height,
+		Members:        members,
+		BridgeContract: k.GetBridgeContractAddress(ctx),
+		BridgeChainId:  k.GetBridgeChainID(ctx),
+	})
 
 	return valset
 }
@@ -65,8 +63,12 @@ func (k Keeper) HasValsetRequest(ctx sdk.Context, nonce uint64) bool {
 	return store.Has(types.GetValsetKey(nonce))
 }
 
-// DeleteValset deletes the valset at a given nonce from state
+// DeleteValset deletes the valset at a given nonce from state, its confirms are pruned once the
+// SignedValsetsWindow has passed
 func (k Keeper) DeleteValset(ctx sdk.Context, nonce uint64) {
+	if valset := k.GetValset(ctx, nonce); valset != nil {
+		k.scheduleConfirmPruning(ctx, valset.Height+k.GetParams(ctx).SignedValsetsWindow, types.GetValsetConfirmKey(nonce, nil))
+	}
 	ctx.KVStore(k.storeKey).Delete(types.GetValsetKey(nonce))
 }
 
@@ -168,8 +170,9 @@ func (k Keeper) GetLastUnBondingBlockHeight(ctx sdk.Context) uint64 {
 	return types.UInt64FromBytes(bytes)
 }
 
-// GetUnSlashedValsets returns all the "ready-to-slash" unslashed validator sets in state (valsets at least signedValsetsWindow blocks old)
-func (k Keeper) GetUnSlashedValsets(ctx sdk.Context, signedValsetsWindow uint64) (out []*types.Valset) {
+// GetUnSlashedValsets returns up to limit of the "ready-to-slash" unslashed validator sets in state (valsets at least
+// signedValsetsWindow blocks old) in ASC order of nonce
+func (k Keeper) GetUnSlashedValsets(ctx sdk.Context, signedValsetsWindow uint64, limit uint64) (out []*types.Valset) {
 	lastSlashedValsetNonce := k.GetLastSlashedValsetNonce(ctx)
 	blockHeight := uint64(ctx.BlockHeight())
 	k.IterateValsetBySlashedValsetNonce(ctx, lastSlashedValsetNonce, func(_ []byte, valset *types.Valset) bool {
@@ -178,7 +181,7 @@ func (k Keeper) GetUnSlashedValsets(ctx sdk.Context, signedValsetsWindow uint64)
 		if valset.Nonce > lastSlashedValsetNonce && !(blockHeight < valset.Height+signedValsetsWindow) {
 			out = append(out, valset)
 		}
-		return false
+		return uint64(len(out)) >= limit
 	})
 	return
 }
@@ -227,29 +230,15 @@ func (k Keeper) IterateValsetBySlashedValsetNonce(ctx sdk.Context, lastSlashedVa
 // you should call this function, evaluate if you want to save this new valset, and discard
 // it or save
 func (k Keeper) GetCurrentValset(ctx sdk.Context) *types.Valset {
-	validators := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
-	// allocate enough space for all validators, but len zero, we then append
-	// so that we have an array with extra capacity but the correct length depending
-	// on how many validators have keys set.
-	bridgeValidators := make([]*types.BridgeValidator, 0, len(validators))
-	var totalPower uint64
-	// TODO someone with in depth info on Cosmos staking should determine
-	// if this is doing what I think it's doing
-	for _, validator := range validators {
-		val := validator.GetOperator()
-
-		p := uint64(k.StakingKeeper.GetLastValidatorPower(ctx, val))
-
-		if ethAddr, found := k.GetEthAddressByValidator(ctx, val); found {
-			bv := &types.BridgeValidator{Power: p, EthereumAddress: ethAddr}
-			bridgeValidators = append(bridgeValidators, bv)
-			totalPower += p
+	members, totalPower := k.GetCurrentBridgeValidators(ctx)
+	bridgeValidators := make([]*types.BridgeValidator, len(members))
+	// normalize power values over the members of the bridge validator set
+	for i, member := range members {
+		bridgeValidators[i] = &types.BridgeValidator{
+			Power:           sdk.NewUint(member.Power).MulUint64(math.MaxUint32).QuoUint64(totalPower).Uint64(),
+			EthereumAddress: member.EthereumAddress,
 		}
 	}
-	// normalize power values
-	for i := range bridgeValidators {
-		bridgeValidators[i].Power = sdk.NewUint(bridgeValidators[i].Power).MulUint64(math.MaxUint32).QuoUint64(totalPower).Uint64()
-	}
 
 	// get the reward from the params store
 	reward := k.GetParams(ctx).ValsetReward
@@ -272,6 +261,59 @@ func (k Keeper) GetCurrentValset(ctx sdk.Context) *types.Valset {
 	return types.NewValset(valsetNonce, uint64(ctx.BlockHeight()), bridgeValidators, rewardAmount, rewardToken)
 }
 
+// BridgeValidatorMember is a validator selected for the bridge validator set along with its Cosmos power
+type BridgeValidatorMember struct {
+	Operator        sdk.ValAddress
+	Power           uint64
+	EthereumAddress string
+}
+
+// GetCurrentBridgeValidators returns the validators that would make up the bridge validator set if a valset was
+// made now, sorted by power, along with their total Cosmos power. Only bonded validators with Ethereum keys are
+// considered. Relaying a valset or batch to Ethereum costs gas for every member, so the set is limited to the
+// MaxBridgeValidators most powerful validators, unless more are needed to hold MinBridgePower of the total bonded
+// power. If the validators with keys don't hold that much power between them they are all members.
+func (k Keeper) GetCurrentBridgeValidators(ctx sdk.Context) (members []BridgeValidatorMember, totalPower uint64) {
+	params := k.GetParams(ctx)
+	validators := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	// allocate enough space for all validators, but len zero, we then append
+	// so that we have an array with extra capacity but the correct length depending
+	// on how many validators have keys set.
+	candidates := make([]BridgeValidatorMember, 0, len(validators))
+	for _, validator := range validators {
+		val := validator.GetOperator()
+		p := uint64(k.StakingKeeper.GetLastValidatorPower(ctx, val))
+		if ethAddr, found := k.GetEthAddressByValidator(ctx, val); found {
+			candidates = append(candidates, BridgeValidatorMember{Operator: val, Power: p, EthereumAddress: ethAddr})
+		}
+	}
+	// the bonded validators are sorted by their current power, the set uses the power of the last block
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].Power > candidates[j].Power })
+
+	minPower := params.MinBridgePower.MulInt(k.StakingKeeper.GetLastTotalPower(ctx))
+	for i, candidate := range candidates {
+		if uint64(i) >= params.MaxBridgeValidators && sdk.NewDec(int64(totalPower)).GTE(minPower) {
+			break
+		}
+		members = append(members, candidate)
+		totalPower += candidate.Power
+	}
+	return members, totalPower
+}
+
+// GetValsetAtHeight returns the latest valset created at or before the given height, this is the bridge validator
+// set that was expected to sign anything created at that height. Returns nil if there is no such valset in state.
+func (k Keeper) GetValsetAtHeight(ctx sdk.Context, height uint64) (out *types.Valset) {
+	k.IterateValsets(ctx, func(_ []byte, valset *types.Valset) bool {
+		if valset.Height <= height {
+			out = valset
+			return true
+		}
+		return false
+	})
+	return
+}
+
 /////////////////////////////
 //     VALSET CONFIRMS     //
 /////////////////////////////
@@ -28,6 +28,7 @@ var _ types.MsgServer = msgServer{
 	Keeper: Keeper{
 		StakingKeeper:      nil,
 		storeKey:           nil,
+		tStoreKey:          nil,
 		paramSpace:         paramstypes.Subspace{},
 		cdc:                nil,
 		bankKeeper:         nil,
@@ -62,6 +63,12 @@ func (k msgServer) SetOrchestratorAddress(c context.Context, msg *types.MsgSetOr
 	// set the ethereum address
 	k.SetEthAddressForValidator(ctx, val, msg.EthAddress)
 
+	// a bonded validator registering keys joins the bridge validator set, request a valset that includes it
+	if _, awaiting := k.GetValidatorAwaitingKeys(ctx, val); awaiting {
+		k.deleteValidatorAwaitingKeys(ctx, val)
+		k.SetLastKeyRegistrationBlockHeight(ctx, uint64(ctx.BlockHeight()))
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -231,24 +238,6 @@ func (k msgServer) ConfirmLogicCall(c context.Context, msg *types.MsgConfirmLogi
 	return nil, nil
 }
 
-// checkOrchestratorValidatorInSet checks that the orchestrator refers to a validator that is
-// currently in the set
-func (k msgServer) checkOrchestratorValidatorInSet(ctx sdk.Context, orchestrator string) error {
-	orchaddr, _ := sdk.AccAddressFromBech32(orchestrator)
-	validator, found := k.GetOrchestratorValidator(ctx, orchaddr)
-	if !found {
-		return sdkerrors.Wrap(types.ErrUnknown, "validator")
-	}
-
-	// return an error if the validator isn't in the active set
-	val := k.StakingKeeper.Validator(ctx, validator.GetOperator())
-	if val == nil || !val.IsBonded() {
-		return sdkerrors.Wrap(sdkerrors.ErrorInvalidSigner, "validator not in active set")
-	}
-
-	return nil
-}
-
 // claimHandlerCommon is an internal function that provides common code for processing claims once they are
 // translated from the message to the Ethereum claim interface
 func (k msgServer) claimHandlerCommon(ctx sdk.Context, msgAny *codectypes.Any, msg types.EthereumClaim) error {
@@ -262,17 +251,17 @@ func (k msgServer) claimHandlerCommon(ctx sdk.Context, msgAny *codectypes.Any, m
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
-			sdk.NewAttribute(sdk.AttributeKeyModule, string(msg.GetType())),
-			// TODO: maybe return something better here? is this the right string representation?
-			sdk.NewAttribute(types.AttributeKeyAttestationID, string(types.GetAttestationKey(msg.GetEventNonce(), msg.ClaimHash()))),
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.GetType().String()),
+			sdk.NewAttribute(types.AttributeKeyAttestationID, types.GetAttestationID(msg)),
 		),
 	)
 
 	return nil
 }
 
-// confirmHandlerCommon is an internal function that provides common code for processing claim messages
-func (k msgServer) confirmHandlerCommon(ctx sdk.Context, orchestrator string, signature string, checkpoint []byte) error {
+// confirmHandlerCommon is an internal function that provides common code for processing confirm messages, it
+// verifies the Ethereum signature of a confirm and is also used to check confirms before they enter the mempool
+func (k Keeper) confirmHandlerCommon(ctx sdk.Context, orchestrator string, signature string, checkpoint []byte) error {
 	sigBytes, err := hex.DecodeString(signature)
 	if err != nil {
 		return sdkerrors.Wrap(types.ErrInvalid, "signature decoding")
@@ -403,6 +392,60 @@ func (k msgServer) ValsetUpdateClaim(c context.Context, msg *types.MsgValsetUpda
 	return &types.MsgValsetUpdatedClaimResponse{}, nil
 }
 
+// EthereumHeightClaim handles heartbeat claims reporting the current Ethereum block height
+func (k msgServer) EthereumHeightClaim(c context.Context, msg *types.MsgEthereumHeightClaim) (*types.MsgEthereumHeightClaimResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	err := k.checkOrchestratorValidatorInSet(ctx, msg.Orchestrator)
+	if err != nil {
+		return nil, err
+	}
+	any, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+	err = k.claimHandlerCommon(ctx, any, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgEthereumHeightClaimResponse{}, nil
+}
+
+// SubmitClaims handles MsgSubmitClaims, each claim goes through claimHandlerCommon in event nonce order.
+// Any failing claim fails the whole message so that none of the claims are recorded
+func (k msgServer) SubmitClaims(c context.Context, msg *types.MsgSubmitClaims) (*types.MsgSubmitClaimsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	err := k.checkOrchestratorValidatorInSet(ctx, msg.Orchestrator)
+	if err != nil {
+		return nil, err
+	}
+	claims, err := msg.GetEthereumClaims()
+	if err != nil {
+		return nil, err
+	}
+
+	// claims are written to a cache and only committed once all of them are accepted
+	cacheCtx, commit := ctx.CacheContext()
+	results := make([]types.ClaimResult, len(claims))
+	for i, claim := range claims {
+		err = k.claimHandlerCommon(cacheCtx, msg.Claims[i], claim)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "claim %d", i)
+		}
+		results[i] = types.ClaimResult{
+			EventNonce: claim.GetEventNonce(),
+			ClaimType:  claim.GetType(),
+			ClaimHash:  claim.ClaimHash(),
+		}
+	}
+	commit()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return &types.MsgSubmitClaimsResponse{Results: results}, nil
+}
+
 func (k msgServer) CancelSendToEth(c context.Context, msg *types.MsgCancelSendToEth) (*types.MsgCancelSendToEthResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	sender, err := sdk.AccAddressFromBech32(msg.Sender)
@@ -428,7 +471,11 @@ func (k msgServer) CancelSendToEth(c context.Context, msg *types.MsgCancelSendTo
 func (k msgServer) SubmitBadSignatureEvidence(c context.Context, msg *types.MsgSubmitBadSignatureEvidence) (*types.MsgSubmitBadSignatureEvidenceResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	err := k.CheckBadSignatureEvidence(ctx, msg)
+	// the message is kept for compatibility, the evidence itself is handled by x/evidence
+	err := k.EvidenceKeeper.SubmitEvidence(ctx, types.NewBadEthSignatureEvidence(msg, ctx.BlockHeight()))
+	if err != nil {
+		return nil, err
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -439,5 +486,5 @@ func (k msgServer) SubmitBadSignatureEvidence(c context.Context, msg *types.MsgS
 		),
 	)
 
-	return &types.MsgSubmitBadSignatureEvidenceResponse{}, err
+	return &types.MsgSubmitBadSignatureEvidenceResponse{}, nil
 }
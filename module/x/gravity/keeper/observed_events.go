@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//  OBSERVED EVENT ARCHIVE //
+/////////////////////////////
+
+// SetObservedEventRecord stores the archive record of an observed event
+func (k Keeper) SetObservedEventRecord(ctx sdk.Context, record types.ObservedEventRecord) {
+	ctx.KVStore(k.storeKey).Set(types.GetObservedEventRecordKey(record.EventNonce), k.cdc.MustMarshalBinaryBare(&record))
+}
+
+// GetObservedEventRecord returns the archive record of the event observed at the given event nonce
+func (k Keeper) GetObservedEventRecord(ctx sdk.Context, eventNonce uint64) (types.ObservedEventRecord, bool) {
+	var record types.ObservedEventRecord
+	bz := ctx.KVStore(k.storeKey).Get(types.GetObservedEventRecordKey(eventNonce))
+	if bz == nil {
+		return record, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &record)
+	return record, true
+}
+
+// IterateObservedEventRecords iterates through the archive of observed events in ASC event nonce order
+func (k Keeper) IterateObservedEventRecords(ctx sdk.Context, cb func(types.ObservedEventRecord) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ObservedEventRecordKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var record types.ObservedEventRecord
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &record)
+		// cb returns true to stop early
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// GetObservedEventRecords returns the whole archive of observed events
+func (k Keeper) GetObservedEventRecords(ctx sdk.Context) (out []types.ObservedEventRecord) {
+	k.IterateObservedEventRecords(ctx, func(record types.ObservedEventRecord) bool {
+		out = append(out, record)
+		return false
+	})
+	return
+}
+
+// ArchiveObservedAttestation makes sure an observed attestation is in the archive before it is pruned. Events
+// are archived as they are observed, those observed before the archive existed are archived with the height
+// the attestation was created at and the current power of its voters.
+func (k Keeper) ArchiveObservedAttestation(ctx sdk.Context, att *types.Attestation) {
+	if !att.Observed {
+		return
+	}
+	claim, err := k.UnpackAttestationClaim(att)
+	if err != nil {
+		panic("could not cast to claim")
+	}
+	if _, found := k.GetObservedEventRecord(ctx, claim.GetEventNonce()); found {
+		return
+	}
+	power := sdk.ZeroInt()
+	for _, vote := range att.Votes {
+		val, err := sdk.ValAddressFromBech32(vote)
+		if err != nil {
+			panic(err)
+		}
+		power = power.Add(sdk.NewInt(k.StakingKeeper.GetLastValidatorPower(ctx, val)))
+	}
+	k.SetObservedEventRecord(ctx, types.NewObservedEventRecord(claim, att.Height, power))
+}
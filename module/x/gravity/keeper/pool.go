@@ -4,8 +4,9 @@ import (
 	"encoding/binary"
 	"fmt"
 	"sort"
-	"strconv"
 
+	metrics "github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -39,6 +40,12 @@ func (k Keeper) AddToOutgoingPool(
 		return 0, err
 	}
 
+	// tokens that have timed out too many batches in a row can not be sent until governance
+	// lifts the suspension
+	if k.IsTokenSuspended(ctx, tokenContract) {
+		return 0, sdkerrors.Wrap(types.ErrTokenSuspended, tokenContract)
+	}
+
 	// If it is a cosmos-originated asset we lock it
 	if isCosmosOriginated {
 		// lock coins in module
@@ -80,15 +87,20 @@ func (k Keeper) AddToOutgoingPool(
 	// todo: add second index for sender so that we can easily query: give pending Tx by sender
 	// todo: what about a second index for receiver?
 
-	poolEvent := sdk.NewEvent(
-		types.EventTypeBridgeWithdrawalReceived,
-		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-		sdk.NewAttribute(types.AttributeKeyContract, k.GetBridgeContractAddress(ctx)),
-		sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.GetBridgeChainID(ctx)))),
-		sdk.NewAttribute(types.AttributeKeyOutgoingTXID, strconv.Itoa(int(nextID))),
-		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(nextID)),
+	k.EmitTypedEvent(ctx, &types.EventOutgoingTxPooled{
+		TxId:           nextID,
+		Sender:         outgoing.Sender,
+		DestAddress:    outgoing.DestAddress,
+		Amount:         *outgoing.Erc20Token,
+		Fee:            *outgoing.Erc20Fee,
+		BridgeContract: k.GetBridgeContractAddress(ctx),
+		BridgeChainId:  k.GetBridgeChainID(ctx),
+	})
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, types.MetricKeyWithdrawals},
+		1,
+		[]metrics.Label{telemetry.NewLabel(types.MetricLabelTokenContract, tokenContract)},
 	)
-	ctx.EventManager().EmitEvent(poolEvent)
 
 	return nextID, nil
 }
@@ -134,6 +146,12 @@ func (k Keeper) RemoveFromOutgoingPoolAndRefund(ctx sdk.Context, txId uint64, se
 		return sdkerrors.Wrapf(types.ErrInvalid, "tx with id %d was not fully removed from the pool, a duplicate must exist", txId)
 	}
 
+	return k.refundOutgoingTx(ctx, sender, tx)
+}
+
+// refundOutgoingTx reissues the amount and fee of a tx that has already been removed
+// from the pool back to its sender
+func (k Keeper) refundOutgoingTx(ctx sdk.Context, sender sdk.AccAddress, tx *types.OutgoingTransferTx) error {
 	// reissue the amount and the fee
 	totalToRefund := tx.Erc20Token.GravityCoin()
 	totalToRefund.Amount = totalToRefund.Amount.Add(tx.Erc20Fee.Amount)
@@ -152,22 +170,40 @@ func (k Keeper) RemoveFromOutgoingPoolAndRefund(ctx sdk.Context, txId uint64, se
 		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, totalToRefundCoins); err != nil {
 			return sdkerrors.Wrapf(err, "mint vouchers coins: %s", totalToRefundCoins)
 		}
-		if err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, totalToRefundCoins); err != nil {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, totalToRefundCoins); err != nil {
 			return sdkerrors.Wrap(err, "transfer vouchers")
 		}
 	}
 
-	poolEvent := sdk.NewEvent(
-		types.EventTypeBridgeWithdrawCanceled,
-		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-		sdk.NewAttribute(types.AttributeKeyContract, k.GetBridgeContractAddress(ctx)),
-		sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.GetBridgeChainID(ctx)))),
-	)
-	ctx.EventManager().EmitEvent(poolEvent)
+	k.EmitTypedEvent(ctx, &types.EventOutgoingTxCanceled{
+		TxId:           tx.Id,
+		Sender:         sender.String(),
+		Refund:         totalToRefundCoins,
+		BridgeContract: k.GetBridgeContractAddress(ctx),
+		BridgeChainId:  k.GetBridgeChainID(ctx),
+	})
 
 	return nil
 }
 
+// refundUnbatchedTransactionsByContract removes every unbatched transaction for the given
+// token contract from the pool and refunds it to its sender, this is used once a token has
+// been suspended and its transactions can no longer be batched
+func (k Keeper) refundUnbatchedTransactionsByContract(ctx sdk.Context, tokenContract string) {
+	for _, tx := range k.GetUnbatchedTransactionsByContract(ctx, tokenContract) {
+		if err := k.removeUnbatchedTX(ctx, *tx.Erc20Fee, tx.Id); err != nil {
+			panic(sdkerrors.Wrapf(err, "unable to remove transaction %d from the pool", tx.Id))
+		}
+		sender, err := sdk.AccAddressFromBech32(tx.Sender)
+		if err != nil {
+			panic("Invalid address in store!")
+		}
+		if err := k.refundOutgoingTx(ctx, sender, tx); err != nil {
+			panic(sdkerrors.Wrapf(err, "unable to refund transaction %d", tx.Id))
+		}
+	}
+}
+
 // addUnbatchedTx creates a new transaction in the pool
 // WARNING: Do not make this function public
 func (k Keeper) addUnbatchedTX(ctx sdk.Context, val *types.OutgoingTransferTx) error {
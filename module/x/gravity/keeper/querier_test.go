@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -1034,3 +1035,170 @@ func TestQueryPendingSendToEth(t *testing.T) {
 
 	assert.JSONEq(t, string(expectedJSON), string(response), "json is equal")
 }
+
+//nolint: exhaustivestruct
+func TestQueryBridgeStatus(t *testing.T) {
+	input, ctx := SetupTestChain(t, 4)
+	k := input.GravityKeeper
+	validators := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	tokenContract := "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+
+	// one of the four validators signs the pending batch
+	k.StoreBatch(ctx, &types.OutgoingTxBatch{
+		BatchNonce:    1,
+		BatchTimeout:  1000,
+		TokenContract: tokenContract,
+	})
+	k.SetBatchConfirm(ctx, &types.MsgConfirmBatch{
+		Nonce:         1,
+		TokenContract: tokenContract,
+		Orchestrator:  sdk.AccAddress(validators[0].GetOperator()).String(),
+	})
+	k.setLastObservedEventNonce(ctx, 3)
+	k.setLastEventNonceByValidator(ctx, validators[0].GetOperator(), 3)
+
+	res, err := k.BridgeStatus(sdk.WrapSDKContext(ctx), &types.QueryBridgeStatusRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), res.LastObservedEventNonce)
+	require.Len(t, res.PendingBatches, 1)
+	assert.Equal(t, uint64(1), res.PendingBatches[0].BatchNonce)
+	assert.Equal(t, sdk.NewDec(25), res.PendingBatches[0].ConfirmedPower)
+	assert.Empty(t, res.PendingLogicCalls)
+	require.Len(t, res.Validators, 4)
+	for _, status := range res.Validators {
+		val, err := sdk.ValAddressFromBech32(status.Validator)
+		require.NoError(t, err)
+		assert.True(t, status.DelegateKeysSet)
+		assert.Equal(t, sdk.AccAddress(val).String(), status.Orchestrator)
+		assert.Equal(t, gethcommon.BytesToAddress(val).String(), status.EthereumAddress)
+		if val.Equals(validators[0].GetOperator()) {
+			assert.Equal(t, uint64(3), status.LastEventNonce)
+		} else {
+			assert.Equal(t, uint64(2), status.LastEventNonce)
+		}
+	}
+	assert.Empty(t, res.SuspendedTokens)
+}
+
+//nolint: exhaustivestruct
+func TestQueryPagination(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _    = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		otherSender, _ = sdk.AccAddressFromBech32("cosmos1990z7dqsvh8gthw9pa5sn4wuy2xrsd80mg5z6y")
+		myReceiver     = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		tokens         = []string{
+			"0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+			"0x7D1AfA7B718fb893dB30A3aBc0Cfc608AaCfeBB0",
+			"0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48",
+		}
+	)
+	for _, token := range tokens {
+		vouchers := sdk.NewCoins(types.NewERC20Token(99999, token).GravityCoin())
+		require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers.Add(vouchers...)))
+		for _, sender := range []sdk.AccAddress{mySender, otherSender} {
+			input.AccountKeeper.NewAccountWithAddress(ctx, sender)
+			require.NoError(t, input.BankKeeper.SetBalances(ctx, sender, input.BankKeeper.GetAllBalances(ctx, sender).Add(vouchers...)))
+			for _, fee := range []uint64{1, 2} {
+				amount := types.NewERC20Token(100, token).GravityCoin()
+				_, err := k.AddToOutgoingPool(ctx, sender, myReceiver, amount, types.NewERC20Token(fee, token).GravityCoin())
+				require.NoError(t, err)
+			}
+		}
+	}
+	c := sdk.WrapSDKContext(ctx)
+
+	// the tokens are paged through by contract
+	fees, err := k.BatchFees(c, &types.QueryBatchFeeRequest{Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, fees.BatchFees, 2)
+	assert.Equal(t, tokens[0], fees.BatchFees[0].Token)
+	assert.Equal(t, sdk.NewInt(6), fees.BatchFees[0].TotalFees)
+	assert.Equal(t, uint64(3), fees.Pagination.Total)
+	fees, err = k.BatchFees(c, &types.QueryBatchFeeRequest{Pagination: &query.PageRequest{Key: fees.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Len(t, fees.BatchFees, 1)
+	assert.Equal(t, tokens[2], fees.BatchFees[0].Token)
+	assert.Nil(t, fees.Pagination.NextKey)
+
+	// pending transfers can be filtered by sender and paged through
+	pending, err := k.GetPendingSendToEth(c, &types.QueryPendingSendToEth{SenderAddress: mySender.String()})
+	require.NoError(t, err)
+	assert.Len(t, pending.UnbatchedTransfers, 6)
+	pending, err = k.GetPendingSendToEth(c, &types.QueryPendingSendToEth{Pagination: &query.PageRequest{Limit: 5}})
+	require.NoError(t, err)
+	assert.Len(t, pending.UnbatchedTransfers, 5)
+	assert.NotNil(t, pending.Pagination.NextKey)
+	pending, err = k.GetPendingSendToEth(c, &types.QueryPendingSendToEth{Pagination: &query.PageRequest{Key: pending.Pagination.NextKey}})
+	require.NoError(t, err)
+	assert.Len(t, pending.UnbatchedTransfers, 7)
+
+	// batches can be filtered by token
+	for _, token := range tokens {
+		_, err := k.BuildOutgoingTXBatch(ctx, token, 2)
+		require.NoError(t, err)
+	}
+	batches, err := k.OutgoingTxBatches(c, &types.QueryOutgoingTxBatchesRequest{TokenContract: tokens[1]})
+	require.NoError(t, err)
+	require.Len(t, batches.Batches, 1)
+	assert.Equal(t, tokens[1], batches.Batches[0].TokenContract)
+	batches, err = k.OutgoingTxBatches(c, &types.QueryOutgoingTxBatchesRequest{Pagination: &query.PageRequest{Limit: 2}})
+	require.NoError(t, err)
+	assert.Len(t, batches.Batches, 2)
+	assert.NotNil(t, batches.Pagination.NextKey)
+
+	// transfers in batches are paged through by batch, each batch holds one transfer of each sender
+	pending, err = k.GetPendingSendToEth(c, &types.QueryPendingSendToEth{
+		SenderAddress:     mySender.String(),
+		BatchesPagination: &query.PageRequest{Limit: 2},
+	})
+	require.NoError(t, err)
+	assert.Len(t, pending.TransfersInBatches, 2)
+	for _, tx := range pending.TransfersInBatches {
+		assert.Equal(t, mySender.String(), tx.Sender)
+	}
+	assert.Len(t, pending.UnbatchedTransfers, 3)
+	require.NotNil(t, pending.BatchesPagination.NextKey)
+	pending, err = k.GetPendingSendToEth(c, &types.QueryPendingSendToEth{
+		SenderAddress:     mySender.String(),
+		BatchesPagination: &query.PageRequest{Key: pending.BatchesPagination.NextKey},
+	})
+	require.NoError(t, err)
+	assert.Len(t, pending.TransfersInBatches, 1)
+	assert.Nil(t, pending.BatchesPagination.NextKey)
+	pending, err = k.GetPendingSendToEth(c, &types.QueryPendingSendToEth{BatchesPagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	require.NoError(t, err)
+	assert.Len(t, pending.TransfersInBatches, 2)
+	assert.Equal(t, uint64(3), pending.BatchesPagination.Total)
+
+	// orchestrator versions and bad signature evidence are paged through by validator and checkpoint
+	for i := 0; i < 3; i++ {
+		val := sdk.ValAddress(bytes.Repeat([]byte{byte(i + 1)}, 20))
+		k.setOrchestratorVersion(ctx, val, types.OrchestratorVersion{Validator: val.String(), Version: "v1", HeightNonce: 1})
+		k.SetBadSignatureEvidence(ctx, bytes.Repeat([]byte{byte(i + 1)}, 32), types.BadSignatureEvidence{
+			EthereumSigner: myReceiver,
+			Validator:      val.String(),
+			SlashedAmount:  sdk.ZeroInt(),
+		})
+	}
+	versions, err := k.OrchestratorVersions(c, &types.QueryOrchestratorVersionsRequest{Pagination: &query.PageRequest{Limit: 2}})
+	require.NoError(t, err)
+	assert.Len(t, versions.Versions, 2)
+	require.NotNil(t, versions.Pagination.NextKey)
+	versions, err = k.OrchestratorVersions(c, &types.QueryOrchestratorVersionsRequest{Pagination: &query.PageRequest{Key: versions.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Len(t, versions.Versions, 1)
+	assert.Equal(t, sdk.ValAddress(bytes.Repeat([]byte{3}, 20)).String(), versions.Versions[0].Validator)
+	assert.Nil(t, versions.Pagination.NextKey)
+	evidence, err := k.BadSignatureEvidence(c, &types.QueryBadSignatureEvidenceRequest{Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	require.NoError(t, err)
+	assert.Len(t, evidence.Evidence, 2)
+	assert.Equal(t, uint64(3), evidence.Pagination.Total)
+	evidence, err = k.BadSignatureEvidence(c, &types.QueryBadSignatureEvidenceRequest{Pagination: &query.PageRequest{Key: evidence.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Len(t, evidence.Evidence, 1)
+	assert.Equal(t, sdk.ValAddress(bytes.Repeat([]byte{3}, 20)).String(), evidence.Evidence[0].Validator)
+	assert.Nil(t, evidence.Pagination.NextKey)
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"math/big"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// SetBridgeGauges reports the state of the bridge to telemetry so that validators can alert on a stalled
+// bridge from their node's metrics, this is called once per block in the EndBlocker. It goes through the whole
+// outgoing pool, so nothing is done unless the node reports telemetry.
+func (k Keeper) SetBridgeGauges(ctx sdk.Context) {
+	if !k.telemetryEnabled {
+		return
+	}
+
+	batches := k.GetOutgoingTxBatches(ctx)
+
+	// the pool is reported per token, tokens with outstanding batches are reported even once their pool is empty
+	poolSize := make(map[string]float32)
+	poolFees := make(map[string]*big.Int)
+	for _, batch := range batches {
+		poolSize[batch.TokenContract] = 0
+		poolFees[batch.TokenContract] = new(big.Int)
+	}
+	k.IterateUnbatchedTransactions(ctx, types.OutgoingTXPoolKey, func(_ []byte, tx *types.OutgoingTransferTx) bool {
+		token := tx.Erc20Fee.Contract
+		if _, ok := poolFees[token]; !ok {
+			poolFees[token] = new(big.Int)
+		}
+		poolSize[token]++
+		poolFees[token].Add(poolFees[token], tx.Erc20Fee.Amount.BigInt())
+		return false
+	})
+	for token, size := range poolSize {
+		labels := []metrics.Label{telemetry.NewLabel(types.MetricLabelTokenContract, token)}
+		fees, _ := new(big.Float).SetInt(poolFees[token]).Float32()
+		telemetry.SetGaugeWithLabels([]string{types.ModuleName, types.MetricKeyPoolSize}, size, labels)
+		telemetry.SetGaugeWithLabels([]string{types.ModuleName, types.MetricKeyPoolFees}, fees, labels)
+	}
+
+	telemetry.SetGauge(float32(len(batches)), types.ModuleName, types.MetricKeyOutstandingBatches)
+	telemetry.SetGauge(float32(len(k.GetOutgoingLogicCalls(ctx))), types.ModuleName, types.MetricKeyOutstandingLogicCalls)
+
+	lastObserved := k.GetLastObservedEventNonce(ctx)
+	telemetry.SetGauge(float32(lastObserved), types.ModuleName, types.MetricKeyLastObservedEventNonce)
+	telemetry.SetGauge(
+		float32(k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight),
+		types.ModuleName, types.MetricKeyLastObservedEthHeight,
+	)
+
+	// the lag is how many observed events the validator has not submitted a claim for yet
+	k.StakingKeeper.IterateBondedValidatorsByPower(ctx, func(_ int64, val stakingtypes.ValidatorI) bool {
+		var lag uint64
+		if last := k.GetLastEventNonceByValidator(ctx, val.GetOperator()); last < lastObserved {
+			lag = lastObserved - last
+		}
+		telemetry.SetGaugeWithLabels(
+			[]string{types.ModuleName, types.MetricKeyValidatorEventNonceLag},
+			float32(lag),
+			[]metrics.Label{telemetry.NewLabel(types.MetricLabelValidator, val.GetOperator().String())},
+		)
+		return false
+	})
+}
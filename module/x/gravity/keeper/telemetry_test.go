@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// Tests that the bridge gauges report the pool, the observed nonce and the event nonce lag of each validator
+func TestSetBridgeGauges(t *testing.T) {
+	input, ctx := SetupTestChain(t, 2)
+	k := input.GravityKeeper
+
+	sink := metrics.NewInmemSink(time.Minute, time.Minute)
+	cfg := metrics.DefaultConfig("")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+	defer metrics.NewGlobal(metrics.DefaultConfig(""), &metrics.BlackholeSink{}) // nolint: errcheck
+
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	)
+	allVouchers := sdk.Coins{types.NewERC20Token(99999, myTokenContractAddr).GravityCoin()}
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allVouchers))
+	for _, fee := range []uint64{2, 3} {
+		amount := types.NewERC20Token(100, myTokenContractAddr).GravityCoin()
+		_, err := k.AddToOutgoingPool(ctx, mySender, myReceiver, amount, types.NewERC20Token(fee, myTokenContractAddr).GravityCoin())
+		require.NoError(t, err)
+	}
+
+	// one validator is caught up, the other one is 4 events behind
+	validators := input.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	k.setLastObservedEventNonce(ctx, 5)
+	k.setLastEventNonceByValidator(ctx, validators[0].GetOperator(), 5)
+	k.setLastEventNonceByValidator(ctx, validators[1].GetOperator(), 1)
+
+	// nothing is reported unless the node reports telemetry
+	k.SetBridgeGauges(ctx)
+	require.Empty(t, sink.Data()[0].Gauges)
+
+	k.SetTelemetryEnabled(true)
+	k.SetBridgeGauges(ctx)
+
+	gauges := sink.Data()[0].Gauges
+	gauge := func(name string, labels ...string) float32 {
+		key := strings.Join(append([]string{"gravity." + name}, labels...), ";")
+		value, ok := gauges[key]
+		require.True(t, ok, key)
+		return value.Value
+	}
+	token := types.MetricLabelTokenContract + "=" + myTokenContractAddr
+	assert.Equal(t, float32(2), gauge(types.MetricKeyPoolSize, token))
+	assert.Equal(t, float32(5), gauge(types.MetricKeyPoolFees, token))
+	assert.Equal(t, float32(0), gauge(types.MetricKeyOutstandingBatches))
+	assert.Equal(t, float32(5), gauge(types.MetricKeyLastObservedEventNonce))
+	assert.Equal(t, float32(0), gauge(types.MetricKeyValidatorEventNonceLag, types.MetricLabelValidator+"="+validators[0].GetOperator().String()))
+	assert.Equal(t, float32(4), gauge(types.MetricKeyValidatorEventNonceLag, types.MetricLabelValidator+"="+validators[1].GetOperator().String()))
+}
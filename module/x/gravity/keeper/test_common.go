@@ -25,16 +25,32 @@ import (
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/capability"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	"github.com/cosmos/cosmos-sdk/x/distribution"
 	distrclient "github.com/cosmos/cosmos-sdk/x/distribution/client"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	evidencekeeper "github.com/cosmos/cosmos-sdk/x/evidence/keeper"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	transfer "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer"
+	ibctransferkeeper "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/keeper"
+	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	ibc "github.com/cosmos/cosmos-sdk/x/ibc/core"
+	ibcclienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
+	ibcconnectiontypes "github.com/cosmos/cosmos-sdk/x/ibc/core/03-connection/types"
+	ibcchanneltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/23-commitment/types"
+	ibchost "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
+	ibckeeper "github.com/cosmos/cosmos-sdk/x/ibc/core/keeper"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/light-clients/07-tendermint/types"
 	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	paramsclient "github.com/cosmos/cosmos-sdk/x/params/client"
@@ -78,6 +94,8 @@ var (
 		upgrade.AppModuleBasic{},
 		evidence.AppModuleBasic{},
 		vesting.AppModuleBasic{},
+		ibc.AppModuleBasic{},
+		transfer.AppModuleBasic{},
 	)
 
 	// Ensure that StakingKeeperMock implements required interface
@@ -186,22 +204,35 @@ var (
 
 	// TestingGravityParams is a set of gravity params for testing
 	TestingGravityParams = types.Params{
-		GravityId:                    "testgravityid",
-		ContractSourceHash:           "62328f7bc12efb28f86111d08c29b39285680a906ea0e524e0209d6f6657b713",
-		BridgeEthereumAddress:        "0x8858eeb3dfffa017d4bce9801d340d36cf895ccf",
-		BridgeChainId:                11,
-		SignedValsetsWindow:          10,
-		SignedBatchesWindow:          10,
-		SignedLogicCallsWindow:       10,
-		TargetBatchTimeout:           60001,
-		AverageBlockTime:             5000,
-		AverageEthereumBlockTime:     15000,
-		SlashFractionValset:          sdk.NewDecWithPrec(1, 2),
-		SlashFractionBatch:           sdk.NewDecWithPrec(1, 2),
-		SlashFractionLogicCall:       sdk.Dec{},
-		UnbondSlashingValsetsWindow:  15,
-		SlashFractionBadEthSignature: sdk.NewDecWithPrec(1, 2),
-		ValsetReward:                 sdk.Coin{Denom: "", Amount: sdk.ZeroInt()},
+		GravityId:                       "testgravityid",
+		ContractSourceHash:              "62328f7bc12efb28f86111d08c29b39285680a906ea0e524e0209d6f6657b713",
+		BridgeEthereumAddress:           "0x8858eeb3dfffa017d4bce9801d340d36cf895ccf",
+		BridgeChainId:                   11,
+		SignedValsetsWindow:             10,
+		SignedBatchesWindow:             10,
+		SignedLogicCallsWindow:          10,
+		TargetBatchTimeout:              60001,
+		AverageBlockTime:                5000,
+		AverageEthereumBlockTime:        15000,
+		SlashFractionValset:             sdk.NewDecWithPrec(1, 2),
+		SlashFractionBatch:              sdk.NewDecWithPrec(1, 2),
+		SlashFractionLogicCall:          sdk.Dec{},
+		UnbondSlashingValsetsWindow:     15,
+		SlashFractionBadEthSignature:    sdk.NewDecWithPrec(1, 2),
+		ValsetReward:                    sdk.Coin{Denom: "", Amount: sdk.ZeroInt()},
+		BatchTimeoutSuspensionThreshold: 3,
+		MaxSlashingItemsPerBlock:        10,
+		ConfirmsWindow:                  10,
+		MinConfirmsPerWindow:            sdk.OneDec(),
+		JailForMissedConfirms:           true,
+		BadSignatureEvidenceBounty:      sdk.NewDecWithPrec(1, 1),
+		KeyRegistrationGracePeriod:      10,
+		MaxBridgeValidators:             100,
+		MinBridgePower:                  sdk.NewDecWithPrec(9, 1),
+		MaxValsetAge:                    1000,
+		AttestationsToKeep:              1000,
+		FeeExemptOrchestratorMsgs:       20,
+		FeeExemptMaxGas:                 2000000,
 	}
 )
 
@@ -211,12 +242,18 @@ type TestInput struct {
 	AccountKeeper  authkeeper.AccountKeeper
 	StakingKeeper  stakingkeeper.Keeper
 	SlashingKeeper slashingkeeper.Keeper
+	EvidenceKeeper evidencekeeper.Keeper
 	DistKeeper     distrkeeper.Keeper
 	BankKeeper     bankkeeper.BaseKeeper
 	GovKeeper      govkeeper.Keeper
+	IBCKeeper      *ibckeeper.Keeper
+	TransferKeeper ibctransferkeeper.Keeper
 	Context        sdk.Context
 	Marshaler      codec.Marshaler
 	LegacyAmino    *codec.LegacyAmino
+
+	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
+	ScopedTransferKeeper capabilitykeeper.ScopedKeeper
 }
 
 // SetupFiveValChain does all the initialization for a 5 Validator chain using the keys here
@@ -266,12 +303,60 @@ func SetupFiveValChain(t *testing.T) (TestInput, sdk.Context) {
 	return input, input.Context
 }
 
+// SetupTestChain does all the initialization for a chain with numValidators equally weighted validators using
+// freshly generated keys, each validator's account is also set as its orchestrator. This is used where the
+// five keys above are not enough, such as benchmarks that need a realistically sized validator set.
+func SetupTestChain(t testing.TB, numValidators int) (TestInput, sdk.Context) {
+	t.Helper()
+	input := CreateTestEnv(t)
+
+	// Set the params for our modules
+	stakeParams := TestingStakeParams
+	stakeParams.MaxValidators = uint32(numValidators)
+	input.StakingKeeper.SetParams(input.Context, stakeParams)
+
+	// Initialize each of the validators
+	sh := staking.NewHandler(input.StakingKeeper)
+	valAddrs := make([]sdk.ValAddress, numValidators)
+	for i := range valAddrs {
+		accPubKey := secp256k1.GenPrivKey().PubKey()
+		accAddr := sdk.AccAddress(accPubKey.Address())
+		valAddrs[i] = sdk.ValAddress(accPubKey.Address())
+
+		acc := input.AccountKeeper.NewAccount(
+			input.Context,
+			authtypes.NewBaseAccount(accAddr, accPubKey, uint64(i), 0),
+		)
+		input.BankKeeper.SetBalances(input.Context, acc.GetAddress(), InitCoins)
+		input.AccountKeeper.SetAccount(input.Context, acc)
+
+		_, err := sh(
+			input.Context,
+			NewTestMsgCreateValidator(valAddrs[i], ed25519.GenPrivKey().PubKey(), StakingAmount),
+		)
+		require.NoError(t, err)
+	}
+
+	// Run the staking endblocker to ensure valset is correct in state
+	staking.EndBlocker(input.Context, input.StakingKeeper)
+
+	// Register eth addresses and orchestrators for each validator
+	for _, addr := range valAddrs {
+		input.GravityKeeper.SetEthAddressForValidator(input.Context, addr, gethcommon.BytesToAddress(addr).String())
+		input.GravityKeeper.SetOrchestratorValidator(input.Context, addr, sdk.AccAddress(addr))
+	}
+
+	// Return the test input
+	return input, input.Context
+}
+
 // CreateTestEnv creates the keeper testing environment for gravity
-func CreateTestEnv(t *testing.T) TestInput {
+func CreateTestEnv(t testing.TB) TestInput {
 	t.Helper()
 
 	// Initialize store keys
 	gravityKey := sdk.NewKVStoreKey(types.StoreKey)
+	tkeyGravity := sdk.NewTransientStoreKey(types.TStoreKey)
 	keyAcc := sdk.NewKVStoreKey(authtypes.StoreKey)
 	keyStaking := sdk.NewKVStoreKey(stakingtypes.StoreKey)
 	keyBank := sdk.NewKVStoreKey(banktypes.StoreKey)
@@ -280,11 +365,17 @@ func CreateTestEnv(t *testing.T) TestInput {
 	tkeyParams := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
 	keyGov := sdk.NewKVStoreKey(govtypes.StoreKey)
 	keySlashing := sdk.NewKVStoreKey(slashingtypes.StoreKey)
+	keyEvidence := sdk.NewKVStoreKey(evidencetypes.StoreKey)
+	keyCapability := sdk.NewKVStoreKey(capabilitytypes.StoreKey)
+	memKeyCapability := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)[capabilitytypes.MemStoreKey]
+	keyIBC := sdk.NewKVStoreKey(ibchost.StoreKey)
+	keyTransfer := sdk.NewKVStoreKey(ibctransfertypes.StoreKey)
 
 	// Initialize memory database and mount stores on it
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(gravityKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyGravity, sdk.StoreTypeTransient, db)
 	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, db)
@@ -293,6 +384,11 @@ func CreateTestEnv(t *testing.T) TestInput {
 	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
 	ms.MountStoreWithDB(keyGov, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keySlashing, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyEvidence, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyCapability, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(memKeyCapability, sdk.StoreTypeMemory, db)
+	ms.MountStoreWithDB(keyIBC, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyTransfer, sdk.StoreTypeIAVL, db)
 	err := ms.LoadLatestVersion()
 	require.Nil(t, err)
 
@@ -334,6 +430,8 @@ func CreateTestEnv(t *testing.T) TestInput {
 	paramsKeeper.Subspace(govtypes.ModuleName)
 	paramsKeeper.Subspace(types.DefaultParamspace)
 	paramsKeeper.Subspace(slashingtypes.ModuleName)
+	paramsKeeper.Subspace(ibchost.ModuleName)
+	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
 
 	// this is also used to initialize module accounts for all the map keys
 	maccPerms := map[string][]string{
@@ -343,6 +441,7 @@ func CreateTestEnv(t *testing.T) TestInput {
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:            {authtypes.Burner},
 		types.ModuleName:               {authtypes.Minter, authtypes.Burner},
+		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 	}
 
 	accountKeeper := authkeeper.NewAccountKeeper(
@@ -432,8 +531,33 @@ func CreateTestEnv(t *testing.T) TestInput {
 		&stakingKeeper,
 		getSubspace(paramsKeeper, slashingtypes.ModuleName).WithKeyTable(slashingtypes.ParamKeyTable()),
 	)
+	slashingKeeper.SetParams(ctx, slashingtypes.DefaultParams())
+
+	capabilityKeeper := capabilitykeeper.NewKeeper(marshaler, keyCapability, memKeyCapability)
+	scopedIBCKeeper := capabilityKeeper.ScopeToModule(ibchost.ModuleName)
+	scopedTransferKeeper := capabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
+	capabilityKeeper.InitializeAndSeal(ctx)
 
-	k := NewKeeper(marshaler, gravityKey, getSubspace(paramsKeeper, types.DefaultParamspace), stakingKeeper, bankKeeper, slashingKeeper)
+	ibcKeeper := ibckeeper.NewKeeper(marshaler, keyIBC, getSubspace(paramsKeeper, ibchost.ModuleName), stakingKeeper, scopedIBCKeeper)
+	ibcKeeper.ClientKeeper.SetParams(ctx, ibcclienttypes.DefaultParams())
+
+	transferKeeper := ibctransferkeeper.NewKeeper(
+		marshaler, keyTransfer, getSubspace(paramsKeeper, ibctransfertypes.ModuleName),
+		ibcKeeper.ChannelKeeper, &ibcKeeper.PortKeeper,
+		accountKeeper, bankKeeper, scopedTransferKeeper,
+	)
+	transferKeeper.SetParams(ctx, ibctransfertypes.DefaultParams())
+
+	k := NewKeeper(marshaler, gravityKey, tkeyGravity, getSubspace(paramsKeeper, types.DefaultParamspace), stakingKeeper, bankKeeper, slashingKeeper, transferKeeper, ibcKeeper.ChannelKeeper)
+
+	evidenceKeeper := evidencekeeper.NewKeeper(marshaler, keyEvidence, &stakingKeeper, slashingKeeper)
+	evidenceKeeper.SetRouter(evidencetypes.NewRouter().AddRoute(
+		types.RouteBadEthSignatureEvidence,
+		func(ctx sdk.Context, evidence exported.Evidence) error {
+			return k.HandleBadEthSignatureEvidence(ctx, evidence.(*types.BadEthSignatureEvidence))
+		},
+	))
+	k.SetEvidenceKeeper(evidenceKeeper)
 
 	stakingKeeper = *stakingKeeper.SetHooks(
 		stakingtypes.NewMultiStakingHooks(
@@ -454,11 +578,17 @@ func CreateTestEnv(t *testing.T) TestInput {
 		BankKeeper:     bankKeeper,
 		StakingKeeper:  stakingKeeper,
 		SlashingKeeper: slashingKeeper,
+		EvidenceKeeper: *evidenceKeeper,
 		DistKeeper:     distKeeper,
 		GovKeeper:      govKeeper,
+		IBCKeeper:      ibcKeeper,
+		TransferKeeper: transferKeeper,
 		Context:        ctx,
 		Marshaler:      marshaler,
 		LegacyAmino:    cdc,
+
+		ScopedIBCKeeper:      scopedIBCKeeper,
+		ScopedTransferKeeper: scopedTransferKeeper,
 	}
 }
 
@@ -491,6 +621,41 @@ func MakeTestMarshaler() codec.Marshaler {
 	return codec.NewProtoCodec(interfaceRegistry)
 }
 
+// OpenTestTransferChannel sets up an open transfer channel with the given id to a counterparty whose latest
+// consensus state is at the block time of ctx, so that transfers can be sent over it
+func OpenTestTransferChannel(t testing.TB, input TestInput, ctx sdk.Context, channelID string) {
+	t.Helper()
+	clientID := "07-tendermint-" + channelID
+	connectionID := "connection-" + channelID
+	height := ibcclienttypes.NewHeight(0, 1)
+
+	clientState := ibctmtypes.NewClientState(
+		"counterparty", ibctmtypes.DefaultTrustLevel, 7*24*time.Hour, 21*24*time.Hour, time.Minute,
+		height, commitmenttypes.GetSDKSpecs(), []string{"upgrade", "upgradedIBCState"}, false, false,
+	)
+	input.IBCKeeper.ClientKeeper.SetClientState(ctx, clientID, clientState)
+	input.IBCKeeper.ClientKeeper.SetClientConsensusState(ctx, clientID, height, ibctmtypes.NewConsensusState(
+		ctx.BlockTime(), commitmenttypes.NewMerkleRoot([]byte("root")), []byte("next validators hash"),
+	))
+	input.IBCKeeper.ConnectionKeeper.SetConnection(ctx, connectionID, ibcconnectiontypes.NewConnectionEnd(
+		ibcconnectiontypes.OPEN, clientID,
+		ibcconnectiontypes.NewCounterparty(clientID, connectionID, commitmenttypes.NewMerklePrefix([]byte("ibc"))),
+		ibcconnectiontypes.ExportedVersionsToProto(ibcconnectiontypes.GetCompatibleVersions()), 0,
+	))
+	input.IBCKeeper.ChannelKeeper.SetChannel(ctx, ibctransfertypes.PortID, channelID, ibcchanneltypes.NewChannel(
+		ibcchanneltypes.OPEN, ibcchanneltypes.UNORDERED,
+		ibcchanneltypes.NewCounterparty(ibctransfertypes.PortID, channelID),
+		[]string{connectionID}, ibctransfertypes.Version,
+	))
+	input.IBCKeeper.ChannelKeeper.SetNextSequenceSend(ctx, ibctransfertypes.PortID, channelID, 1)
+
+	// the channel capability is owned by both IBC and the transfer module
+	capabilityPath := ibchost.ChannelCapabilityPath(ibctransfertypes.PortID, channelID)
+	capability, err := input.ScopedIBCKeeper.NewCapability(ctx, capabilityPath)
+	require.NoError(t, err)
+	require.NoError(t, input.ScopedTransferKeeper.ClaimCapability(ctx, capability, capabilityPath))
+}
+
 // MintVouchersFromAir creates new gravity vouchers given erc20tokens
 func MintVouchersFromAir(t *testing.T, ctx sdk.Context, k Keeper, dest sdk.AccAddress, amount types.ERC20Token) sdk.Coin {
 	coin := amount.GravityCoin()
@@ -34,6 +34,7 @@ const (
 	CLAIM_TYPE_ERC20_DEPLOYED      ClaimType = 3
 	CLAIM_TYPE_LOGIC_CALL_EXECUTED ClaimType = 4
 	CLAIM_TYPE_VALSET_UPDATED      ClaimType = 5
+	CLAIM_TYPE_ETHEREUM_HEIGHT     ClaimType = 6
 )
 
 var ClaimType_name = map[int32]string{
@@ -43,6 +44,7 @@ var ClaimType_name = map[int32]string{
 	3: "CLAIM_TYPE_ERC20_DEPLOYED",
 	4: "CLAIM_TYPE_LOGIC_CALL_EXECUTED",
 	5: "CLAIM_TYPE_VALSET_UPDATED",
+	6: "CLAIM_TYPE_ETHEREUM_HEIGHT",
 }
 
 var ClaimType_value = map[string]int32{
@@ -52,6 +54,7 @@ var ClaimType_value = map[string]int32{
 	"CLAIM_TYPE_ERC20_DEPLOYED":      3,
 	"CLAIM_TYPE_LOGIC_CALL_EXECUTED": 4,
 	"CLAIM_TYPE_VALSET_UPDATED":      5,
+	"CLAIM_TYPE_ETHEREUM_HEIGHT":     6,
 }
 
 func (x ClaimType) String() string {
@@ -77,11 +80,15 @@ func (ClaimType) EnumDescriptor() ([]byte, []int) {
 // the key in which the attestation is stored is keyed on the exact details of the claim
 // but there is no reason to store those exact details becuause the next message sender
 // will kindly provide you with them.
+// VOTE_DETAILS:
+// The Cosmos height and validator power at the time of each vote, attestations
+// created before vote details were recorded only have votes
 type Attestation struct {
-	Observed bool       `protobuf:"varint,1,opt,name=observed,proto3" json:"observed,omitempty"`
-	Votes    []string   `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes,omitempty"`
-	Height   uint64     `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
-	Claim    *types.Any `protobuf:"bytes,4,opt,name=claim,proto3" json:"claim,omitempty"`
+	Observed    bool              `protobuf:"varint,1,opt,name=observed,proto3" json:"observed,omitempty"`
+	Votes       []string          `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes,omitempty"`
+	Height      uint64            `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Claim       *types.Any        `protobuf:"bytes,4,opt,name=claim,proto3" json:"claim,omitempty"`
+	VoteDetails []AttestationVote `protobuf:"bytes,5,rep,name=vote_details,json=voteDetails,proto3" json:"vote_details"`
 }
 
 func (m *Attestation) Reset()         { *m = Attestation{} }
@@ -145,6 +152,204 @@ func (m *Attestation) GetClaim() *types.Any {
 	return nil
 }
 
+func (m *Attestation) GetVoteDetails() []AttestationVote {
+	if m != nil {
+		return m.VoteDetails
+	}
+	return nil
+}
+
+// AttestationVote is a vote on an attestation along with the Cosmos block
+// height it was cast at and the power of the validator at that height
+type AttestationVote struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Height    uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Power     int64  `protobuf:"varint,3,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *AttestationVote) Reset()         { *m = AttestationVote{} }
+func (m *AttestationVote) String() string { return proto.CompactTextString(m) }
+func (*AttestationVote) ProtoMessage()    {}
+func (*AttestationVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3205613bbab7525, []int{1}
+}
+func (m *AttestationVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationVote.Merge(m, src)
+}
+func (m *AttestationVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationVote proto.InternalMessageInfo
+
+func (m *AttestationVote) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *AttestationVote) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AttestationVote) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+// ObservedEventRecord is a compact record of an observed Ethereum event that is kept
+// after the attestation for the event has been pruned, so the full history of the
+// oracle can be audited.
+// COSMOS_HEIGHT:
+// The Cosmos block height the event was observed at
+// OBSERVED_POWER:
+// The power of the votes counted when the event was observed
+// SUMMARY:
+// token_contract, amount, sender, receiver, denom and nonce summarize the event,
+// which of them are set depends on the claim type. The nonce is the batch nonce,
+// logic call invalidation nonce or valset nonce.
+type ObservedEventRecord struct {
+	EventNonce     uint64                                 `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	ClaimType      ClaimType                              `protobuf:"varint,2,opt,name=claim_type,json=claimType,proto3,enum=gravity.v1.ClaimType" json:"claim_type,omitempty"`
+	ClaimHash      []byte                                 `protobuf:"bytes,3,opt,name=claim_hash,json=claimHash,proto3" json:"claim_hash,omitempty"`
+	EthereumHeight uint64                                 `protobuf:"varint,4,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
+	CosmosHeight   uint64                                 `protobuf:"varint,5,opt,name=cosmos_height,json=cosmosHeight,proto3" json:"cosmos_height,omitempty"`
+	ObservedPower  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=observed_power,json=observedPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"observed_power"`
+	TokenContract  string                                 `protobuf:"bytes,7,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Amount         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	Sender         string                                 `protobuf:"bytes,9,opt,name=sender,proto3" json:"sender,omitempty"`
+	Receiver       string                                 `protobuf:"bytes,10,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Denom          string                                 `protobuf:"bytes,11,opt,name=denom,proto3" json:"denom,omitempty"`
+	Nonce          uint64                                 `protobuf:"varint,12,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *ObservedEventRecord) Reset()         { *m = ObservedEventRecord{} }
+func (m *ObservedEventRecord) String() string { return proto.CompactTextString(m) }
+func (*ObservedEventRecord) ProtoMessage()    {}
+func (*ObservedEventRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3205613bbab7525, []int{2}
+}
+func (m *ObservedEventRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObservedEventRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObservedEventRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ObservedEventRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObservedEventRecord.Merge(m, src)
+}
+func (m *ObservedEventRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObservedEventRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObservedEventRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObservedEventRecord proto.InternalMessageInfo
+
+func (m *ObservedEventRecord) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
+}
+
+func (m *ObservedEventRecord) GetClaimType() ClaimType {
+	if m != nil {
+		return m.ClaimType
+	}
+	return CLAIM_TYPE_UNSPECIFIED
+}
+
+func (m *ObservedEventRecord) GetClaimHash() []byte {
+	if m != nil {
+		return m.ClaimHash
+	}
+	return nil
+}
+
+func (m *ObservedEventRecord) GetEthereumHeight() uint64 {
+	if m != nil {
+		return m.EthereumHeight
+	}
+	return 0
+}
+
+func (m *ObservedEventRecord) GetCosmosHeight() uint64 {
+	if m != nil {
+		return m.CosmosHeight
+	}
+	return 0
+}
+
+func (m *ObservedEventRecord) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *ObservedEventRecord) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *ObservedEventRecord) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *ObservedEventRecord) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ObservedEventRecord) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
 // ERC20Token unique identifier for an Ethereum ERC20 token.
 // CONTRACT:
 // The contract address on ETH of the token, this could be a Cosmos
@@ -159,7 +364,7 @@ func (m *ERC20Token) Reset()         { *m = ERC20Token{} }
 func (m *ERC20Token) String() string { return proto.CompactTextString(m) }
 func (*ERC20Token) ProtoMessage()    {}
 func (*ERC20Token) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3205613bbab7525, []int{1}
+	return fileDescriptor_e3205613bbab7525, []int{3}
 }
 func (m *ERC20Token) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -198,43 +403,63 @@ func (m *ERC20Token) GetContract() string {
 func init() {
 	proto.RegisterEnum("gravity.v1.ClaimType", ClaimType_name, ClaimType_value)
 	proto.RegisterType((*Attestation)(nil), "gravity.v1.Attestation")
+	proto.RegisterType((*AttestationVote)(nil), "gravity.v1.AttestationVote")
+	proto.RegisterType((*ObservedEventRecord)(nil), "gravity.v1.ObservedEventRecord")
 	proto.RegisterType((*ERC20Token)(nil), "gravity.v1.ERC20Token")
 }
 
 func init() { proto.RegisterFile("gravity/v1/attestation.proto", fileDescriptor_e3205613bbab7525) }
 
 var fileDescriptor_e3205613bbab7525 = []byte{
-	// 476 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0x4f, 0x6f, 0x9b, 0x30,
-	0x18, 0xc6, 0x71, 0xfe, 0xa9, 0x71, 0x2f, 0x91, 0x15, 0x55, 0x69, 0xd4, 0xd1, 0x28, 0x87, 0x29,
-	0xaa, 0x14, 0xbc, 0x76, 0x9f, 0x80, 0x80, 0xbb, 0x46, 0xa2, 0x25, 0x02, 0x32, 0xad, 0xd3, 0x24,
-	0x04, 0xc4, 0x23, 0xa8, 0x01, 0x47, 0xe0, 0xa0, 0x71, 0xde, 0x65, 0xc7, 0x7d, 0x87, 0x7d, 0x99,
-	0x1e, 0x73, 0x9c, 0x76, 0xa8, 0xa6, 0xe4, 0x8b, 0x4c, 0x01, 0x92, 0x45, 0x3d, 0xc1, 0xe3, 0xdf,
-	0xeb, 0xd7, 0x8f, 0x1f, 0xbf, 0xf0, 0xc2, 0x8f, 0x9d, 0x34, 0xe0, 0x19, 0x4e, 0xaf, 0xb1, 0xc3,
-	0x39, 0x4d, 0xb8, 0xc3, 0x03, 0x16, 0x49, 0xcb, 0x98, 0x71, 0x86, 0x60, 0x49, 0xa5, 0xf4, 0xba,
-	0xdb, 0xf6, 0x99, 0xcf, 0xf2, 0x65, 0xbc, 0xfb, 0x2b, 0x2a, 0xba, 0xe7, 0x3e, 0x63, 0xfe, 0x82,
-	0xe2, 0x5c, 0xb9, 0xab, 0xaf, 0xd8, 0x89, 0xb2, 0x02, 0xf5, 0xbf, 0x03, 0x78, 0x2a, 0xff, 0x6f,
-	0x89, 0xba, 0xf0, 0x84, 0xb9, 0x09, 0x8d, 0x53, 0x3a, 0xeb, 0x80, 0x1e, 0x18, 0x9c, 0x18, 0x07,
-	0x8d, 0xda, 0xb0, 0x9e, 0x32, 0x4e, 0x93, 0x4e, 0xa5, 0x57, 0x1d, 0x34, 0x8d, 0x42, 0xa0, 0x33,
-	0xd8, 0x98, 0xd3, 0xc0, 0x9f, 0xf3, 0x4e, 0xb5, 0x07, 0x06, 0x35, 0xa3, 0x54, 0xe8, 0x0a, 0xd6,
-	0xbd, 0x85, 0x13, 0x84, 0x9d, 0x5a, 0x0f, 0x0c, 0x4e, 0x6f, 0xda, 0x52, 0x61, 0x42, 0xda, 0x9b,
-	0x90, 0xe4, 0x28, 0x33, 0x8a, 0x92, 0xfe, 0x12, 0x42, 0x62, 0x28, 0x37, 0xef, 0x2c, 0xf6, 0x44,
-	0x73, 0x0f, 0x1e, 0x8b, 0x78, 0xec, 0x78, 0x3c, 0xf7, 0xd0, 0x34, 0x0e, 0x1a, 0xdd, 0xc2, 0x86,
-	0x13, 0xb2, 0x55, 0xc4, 0x3b, 0x95, 0x1d, 0x19, 0x49, 0xcf, 0x2f, 0x97, 0xc2, 0x9f, 0x97, 0xcb,
-	0xb7, 0x7e, 0xc0, 0xe7, 0x2b, 0x57, 0xf2, 0x58, 0x88, 0x3d, 0x96, 0x84, 0x2c, 0x29, 0x3f, 0xc3,
-	0x64, 0xf6, 0x84, 0x79, 0xb6, 0xa4, 0x89, 0x34, 0x8e, 0xb8, 0x51, 0xee, 0xbe, 0x5a, 0x03, 0xd8,
-	0x54, 0x76, 0x67, 0x5b, 0xd9, 0x92, 0xa2, 0x2e, 0x3c, 0x53, 0x34, 0x79, 0x7c, 0x6f, 0x5b, 0x8f,
-	0x13, 0x62, 0x4f, 0x1f, 0xcc, 0x09, 0x51, 0xc6, 0xb7, 0x63, 0xa2, 0xb6, 0x04, 0xf4, 0x06, 0x9e,
-	0x1f, 0x31, 0x93, 0x3c, 0xa8, 0xb6, 0xa5, 0xdb, 0x8a, 0x6e, 0xde, 0xeb, 0x66, 0x0b, 0xa0, 0x1e,
-	0xbc, 0x38, 0xc2, 0x23, 0xd9, 0x52, 0xee, 0x0e, 0x45, 0xc4, 0xba, 0x6b, 0x55, 0x5e, 0x35, 0xc8,
-	0xef, 0x69, 0xab, 0x64, 0xa2, 0xe9, 0x8f, 0x44, 0x6d, 0x55, 0x51, 0x1f, 0x8a, 0x47, 0x58, 0xd3,
-	0x3f, 0x8c, 0x15, 0x5b, 0x91, 0x35, 0xcd, 0x26, 0x9f, 0x88, 0x32, 0xb5, 0x88, 0xda, 0xaa, 0xbd,
-	0x6a, 0xf1, 0x51, 0xd6, 0x4c, 0x62, 0xd9, 0xd3, 0x89, 0x2a, 0xef, 0x70, 0xbd, 0x5b, 0xfb, 0xf1,
-	0x4b, 0x14, 0x46, 0x5f, 0x9e, 0x37, 0x22, 0x58, 0x6f, 0x44, 0xf0, 0x77, 0x23, 0x82, 0x9f, 0x5b,
-	0x51, 0x58, 0x6f, 0x45, 0xe1, 0xf7, 0x56, 0x14, 0x3e, 0x8f, 0x8e, 0xc2, 0x71, 0x16, 0x7c, 0x4e,
-	0x9d, 0x61, 0x44, 0xf9, 0x3e, 0xa0, 0x72, 0x7c, 0x86, 0x6e, 0x1c, 0xcc, 0x7c, 0x8a, 0x43, 0x36,
-	0x5b, 0x2d, 0x28, 0xfe, 0x86, 0xf7, 0x43, 0x97, 0x87, 0xe7, 0x36, 0xf2, 0x77, 0x7b, 0xff, 0x2f,
-	0x00, 0x00, 0xff, 0xff, 0x0f, 0x0b, 0x25, 0x26, 0x8c, 0x02, 0x00, 0x00,
+	// 761 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xdd, 0x8e, 0xdb, 0x44,
+	0x18, 0x8d, 0x37, 0x3f, 0x24, 0x93, 0x6c, 0x1a, 0x0d, 0xa1, 0x72, 0xc3, 0xd6, 0x1b, 0x05, 0x01,
+	0x51, 0xa5, 0xb5, 0x69, 0xe0, 0x05, 0x12, 0x7b, 0xda, 0x44, 0xca, 0x6e, 0x22, 0xc7, 0xa9, 0x28,
+	0x02, 0x59, 0x8e, 0x3d, 0xd8, 0x56, 0x13, 0x4f, 0x64, 0x4f, 0x0c, 0x79, 0x03, 0x2e, 0x79, 0x07,
+	0x5e, 0xa6, 0xdc, 0xf5, 0x12, 0x71, 0x51, 0xa1, 0xdd, 0x17, 0xe0, 0x0d, 0x40, 0x33, 0x63, 0x67,
+	0xcd, 0x72, 0x07, 0x57, 0xeb, 0x73, 0xce, 0xf7, 0x77, 0xbe, 0x6f, 0x36, 0xe0, 0xc2, 0x8f, 0x9d,
+	0x34, 0xa4, 0x47, 0x2d, 0x7d, 0xae, 0x39, 0x94, 0xe2, 0x84, 0x3a, 0x34, 0x24, 0x91, 0xba, 0x8f,
+	0x09, 0x25, 0x10, 0x64, 0xaa, 0x9a, 0x3e, 0xef, 0x75, 0x7d, 0xe2, 0x13, 0x4e, 0x6b, 0xec, 0x4b,
+	0x44, 0xf4, 0x9e, 0xf8, 0x84, 0xf8, 0x5b, 0xac, 0x71, 0xb4, 0x39, 0x7c, 0xaf, 0x39, 0xd1, 0x51,
+	0x48, 0x83, 0x5f, 0x25, 0xd0, 0x1c, 0xdf, 0x97, 0x84, 0x3d, 0x50, 0x27, 0x9b, 0x04, 0xc7, 0x29,
+	0xf6, 0x64, 0xa9, 0x2f, 0x0d, 0xeb, 0xe6, 0x09, 0xc3, 0x2e, 0xa8, 0xa6, 0x84, 0xe2, 0x44, 0x3e,
+	0xeb, 0x97, 0x87, 0x0d, 0x53, 0x00, 0xf8, 0x18, 0xd4, 0x02, 0x1c, 0xfa, 0x01, 0x95, 0xcb, 0x7d,
+	0x69, 0x58, 0x31, 0x33, 0x04, 0x9f, 0x81, 0xaa, 0xbb, 0x75, 0xc2, 0x9d, 0x5c, 0xe9, 0x4b, 0xc3,
+	0xe6, 0xa8, 0xab, 0x8a, 0x21, 0xd4, 0x7c, 0x08, 0x75, 0x1c, 0x1d, 0x4d, 0x11, 0x02, 0x0d, 0xd0,
+	0x62, 0xc5, 0x6c, 0x0f, 0x53, 0x27, 0xdc, 0x26, 0x72, 0xb5, 0x5f, 0x1e, 0x36, 0x47, 0x1f, 0xab,
+	0xf7, 0xce, 0xd4, 0xc2, 0x90, 0xaf, 0x08, 0xc5, 0x93, 0xca, 0xdb, 0xf7, 0x97, 0x25, 0xb3, 0xc9,
+	0xd2, 0x0c, 0x91, 0x35, 0xf8, 0x0e, 0x3c, 0x7a, 0x10, 0x05, 0x2f, 0x40, 0x23, 0x75, 0xb6, 0xa1,
+	0xe7, 0x50, 0x12, 0x73, 0x3f, 0x0d, 0xf3, 0x9e, 0x28, 0x8c, 0x7e, 0xf6, 0x8f, 0xd1, 0xbb, 0xa0,
+	0xba, 0x27, 0x3f, 0xe0, 0x98, 0x3b, 0x2a, 0x9b, 0x02, 0x0c, 0xfe, 0x2a, 0x83, 0x0f, 0x17, 0xd9,
+	0x2e, 0x50, 0x8a, 0x23, 0x6a, 0x62, 0x97, 0xc4, 0x1e, 0xbc, 0x04, 0x4d, 0xcc, 0xa0, 0x1d, 0x91,
+	0xc8, 0xc5, 0xbc, 0x4b, 0xc5, 0x04, 0x9c, 0xba, 0x61, 0x0c, 0xfc, 0x0a, 0x00, 0x6e, 0xd3, 0xa6,
+	0xc7, 0x3d, 0xe6, 0xad, 0xda, 0xa3, 0x8f, 0x8a, 0xde, 0x74, 0xa6, 0x5a, 0xc7, 0x3d, 0x36, 0x1b,
+	0x6e, 0xfe, 0x09, 0x9f, 0xe6, 0x59, 0x81, 0x93, 0x04, 0x7c, 0x92, 0x56, 0x26, 0x4f, 0x9d, 0x24,
+	0x80, 0x9f, 0x83, 0x47, 0x98, 0x06, 0x38, 0xc6, 0x87, 0x9d, 0x9d, 0x99, 0xa8, 0xf0, 0xce, 0xed,
+	0x9c, 0x9e, 0x0a, 0x33, 0x9f, 0x80, 0x73, 0x97, 0x24, 0x3b, 0x92, 0xe4, 0x61, 0x55, 0x1e, 0xd6,
+	0x12, 0x64, 0x16, 0xb4, 0x06, 0xed, 0xfc, 0xcc, 0xb6, 0xb0, 0x5e, 0x63, 0xcb, 0x9a, 0xa8, 0x6c,
+	0xcb, 0xbf, 0xbf, 0xbf, 0xfc, 0xcc, 0x0f, 0x69, 0x70, 0xd8, 0xa8, 0x2e, 0xd9, 0x69, 0x22, 0x31,
+	0xfb, 0x73, 0x95, 0x78, 0x6f, 0x34, 0xe6, 0x2b, 0x51, 0x67, 0x11, 0x35, 0xcf, 0xf3, 0x2a, 0x4b,
+	0x56, 0x04, 0x7e, 0x0a, 0xda, 0x94, 0xbc, 0xc1, 0x91, 0xed, 0x92, 0x88, 0xc6, 0x8e, 0x4b, 0xe5,
+	0x0f, 0xf8, 0x0d, 0xce, 0x39, 0xab, 0x67, 0x24, 0x7c, 0x01, 0x6a, 0xce, 0x8e, 0x1c, 0x22, 0x2a,
+	0xd7, 0xff, 0x53, 0xd7, 0x2c, 0x9b, 0xdd, 0x33, 0xc1, 0x91, 0x87, 0x63, 0xb9, 0xc1, 0xdb, 0x64,
+	0x88, 0x3d, 0xea, 0x18, 0xbb, 0x38, 0x4c, 0x71, 0x2c, 0x03, 0xae, 0x9c, 0x30, 0xbb, 0xb5, 0x87,
+	0x23, 0xb2, 0x93, 0x9b, 0x5c, 0x10, 0x80, 0xb1, 0xe2, 0x9a, 0x2d, 0xbe, 0x2c, 0x01, 0x06, 0x7b,
+	0x00, 0x90, 0xa9, 0x8f, 0xbe, 0xb0, 0xd8, 0xf4, 0xac, 0xea, 0xc9, 0x96, 0x78, 0x5a, 0x75, 0xf7,
+	0xdf, 0x8e, 0xce, 0xfe, 0x8f, 0xa3, 0x67, 0x7f, 0x4a, 0xa0, 0x71, 0x7a, 0x1d, 0xb0, 0x07, 0x1e,
+	0xeb, 0xf3, 0xf1, 0xec, 0xda, 0xb6, 0x5e, 0x2f, 0x91, 0xbd, 0xbe, 0x59, 0x2d, 0x91, 0x3e, 0x7b,
+	0x31, 0x43, 0x46, 0xa7, 0x04, 0x9f, 0x82, 0x27, 0x05, 0x6d, 0x85, 0x6e, 0x0c, 0xdb, 0x5a, 0xd8,
+	0xfa, 0x62, 0x75, 0xbd, 0x58, 0x75, 0x24, 0xd8, 0x07, 0x17, 0x05, 0x79, 0x32, 0xb6, 0xf4, 0xe9,
+	0x29, 0x08, 0x59, 0xd3, 0xce, 0xd9, 0x83, 0x02, 0xdc, 0xa7, 0x6d, 0xa0, 0xe5, 0x7c, 0xf1, 0x1a,
+	0x19, 0x9d, 0x32, 0x1c, 0x00, 0xa5, 0x20, 0xcf, 0x17, 0x2f, 0x67, 0xba, 0xad, 0x8f, 0xe7, 0x73,
+	0x1b, 0x7d, 0x8d, 0xf4, 0xb5, 0x85, 0x8c, 0x4e, 0xe5, 0x41, 0x89, 0x57, 0xe3, 0xf9, 0x0a, 0x59,
+	0xf6, 0x7a, 0x69, 0x8c, 0x99, 0x5c, 0x85, 0x0a, 0xe8, 0x15, 0x3b, 0x58, 0x53, 0x64, 0xa2, 0xf5,
+	0xb5, 0x3d, 0x45, 0xb3, 0x97, 0x53, 0xab, 0x53, 0xeb, 0x55, 0x7e, 0xfa, 0x45, 0x29, 0x4d, 0xbe,
+	0x7d, 0x7b, 0xab, 0x48, 0xef, 0x6e, 0x15, 0xe9, 0x8f, 0x5b, 0x45, 0xfa, 0xf9, 0x4e, 0x29, 0xbd,
+	0xbb, 0x53, 0x4a, 0xbf, 0xdd, 0x29, 0xa5, 0x6f, 0x26, 0x85, 0xe5, 0x39, 0x5b, 0x1a, 0x60, 0xe7,
+	0x2a, 0xc2, 0x34, 0x5f, 0x60, 0xf6, 0xff, 0x74, 0xb5, 0x89, 0x43, 0xcf, 0xc7, 0xda, 0x8e, 0x78,
+	0x87, 0x2d, 0xd6, 0x7e, 0xd4, 0x32, 0x5e, 0x2c, 0x77, 0x53, 0xe3, 0x3f, 0x3f, 0x5f, 0xfe, 0x3d,
+	0x00, 0x0c, 0xce, 0x58, 0xff, 0x53, 0x05, 0x00, 0x00,
 }
 
 func (m *Attestation) Marshal() (dAtA []byte, err error) {
@@ -257,6 +482,20 @@ func (m *Attestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VoteDetails) > 0 {
+		for iNdEx := len(m.VoteDetails) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteDetails[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAttestation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.Claim != nil {
 		{
 			size, err := m.Claim.MarshalToSizedBuffer(dAtA[:i])
@@ -296,6 +535,149 @@ func (m *Attestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AttestationVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ObservedEventRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObservedEventRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObservedEventRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAttestation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.ObservedPower.Size()
+		i -= size
+		if _, err := m.ObservedPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAttestation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.CosmosHeight != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.CosmosHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EthereumHeight != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.EthereumHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ClaimHash) > 0 {
+		i -= len(m.ClaimHash)
+		copy(dAtA[i:], m.ClaimHash)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.ClaimHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ClaimType != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.ClaimType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ERC20Token) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -369,6 +751,79 @@ func (m *Attestation) Size() (n int) {
 		l = m.Claim.Size()
 		n += 1 + l + sovAttestation(uint64(l))
 	}
+	if len(m.VoteDetails) > 0 {
+		for _, e := range m.VoteDetails {
+			l = e.Size()
+			n += 1 + l + sovAttestation(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AttestationVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovAttestation(uint64(m.Height))
+	}
+	if m.Power != 0 {
+		n += 1 + sovAttestation(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *ObservedEventRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovAttestation(uint64(m.EventNonce))
+	}
+	if m.ClaimType != 0 {
+		n += 1 + sovAttestation(uint64(m.ClaimType))
+	}
+	l = len(m.ClaimHash)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	if m.EthereumHeight != 0 {
+		n += 1 + sovAttestation(uint64(m.EthereumHeight))
+	}
+	if m.CosmosHeight != 0 {
+		n += 1 + sovAttestation(uint64(m.CosmosHeight))
+	}
+	l = m.ObservedPower.Size()
+	n += 1 + l + sovAttestation(uint64(l))
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovAttestation(uint64(l))
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovAttestation(uint64(m.Nonce))
+	}
 	return n
 }
 
@@ -529,6 +984,541 @@ func (m *Attestation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteDetails", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteDetails = append(m.VoteDetails, AttestationVote{})
+			if err := m.VoteDetails[len(m.VoteDetails)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObservedEventRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObservedEventRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObservedEventRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimType", wireType)
+			}
+			m.ClaimType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimType |= ClaimType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimHash = append(m.ClaimHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClaimHash == nil {
+				m.ClaimHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
+			}
+			m.EthereumHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosHeight", wireType)
+			}
+			m.CosmosHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObservedPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAttestation(dAtA[iNdEx:])
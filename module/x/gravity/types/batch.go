@@ -68,6 +68,12 @@ func (b OutgoingTxBatch) GetCheckpoint(gravityIDstring string) []byte {
 	return crypto.Keccak256Hash(abiEncodedBatch[4:]).Bytes()
 }
 
+// GetSignedNonce returns the token contract and batch nonce, the contract keeps a batch nonce per token
+func (b OutgoingTxBatch) GetSignedNonce() []byte {
+	nonce := append([]byte{byte(CONFIRM_TYPE_BATCH)}, gethcommon.HexToAddress(b.TokenContract).Bytes()...)
+	return append(nonce, UInt64Bytes(b.BatchNonce)...)
+}
+
 // GetCheckpoint gets the checkpoint signature from the given outgoing tx batch
 func (c OutgoingLogicCall) GetCheckpoint(gravityIDstring string) []byte {
 
@@ -133,3 +139,9 @@ func (c OutgoingLogicCall) GetCheckpoint(gravityIDstring string) []byte {
 
 	return crypto.Keccak256Hash(abiEncodedCall[4:]).Bytes()
 }
+
+// GetSignedNonce returns the invalidation id and nonce
+func (c OutgoingLogicCall) GetSignedNonce() []byte {
+	nonce := append([]byte{byte(CONFIRM_TYPE_LOGIC_CALL)}, c.InvalidationId...)
+	return append(nonce, UInt64Bytes(c.InvalidationNonce)...)
+}
@@ -5,6 +5,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // ModuleCdc is the codec for the module
@@ -31,6 +33,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgValsetUpdatedClaim{},
 		&MsgCancelSendToEth{},
 		&MsgSubmitBadSignatureEvidence{},
+		&MsgEthereumHeightClaim{},
+		&MsgSubmitClaims{},
 	)
 
 	registry.RegisterInterface(
@@ -41,8 +45,13 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgERC20DeployedClaim{},
 		&MsgLogicCallExecutedClaim{},
 		&MsgValsetUpdatedClaim{},
+		&MsgEthereumHeightClaim{},
 	)
 
+	registry.RegisterImplementations((*govtypes.Content)(nil), &UnsuspendTokenProposal{})
+
+	registry.RegisterImplementations((*exported.Evidence)(nil), &BadEthSignatureEvidence{})
+
 	registry.RegisterInterface("gravity.v1beta1.EthereumSigned", (*EthereumSigned)(nil), &Valset{}, &OutgoingTxBatch{}, &OutgoingLogicCall{})
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -71,4 +80,7 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&IDSet{}, "gravity/IDSet", nil)
 	cdc.RegisterConcrete(&Attestation{}, "gravity/Attestation", nil)
 	cdc.RegisterConcrete(&MsgSubmitBadSignatureEvidence{}, "gravity/MsgSubmitBadSignatureEvidence", nil)
+	cdc.RegisterConcrete(&MsgEthereumHeightClaim{}, "gravity/MsgEthereumHeightClaim", nil)
+	cdc.RegisterConcrete(&MsgSubmitClaims{}, "gravity/MsgSubmitClaims", nil)
+	cdc.RegisterConcrete(&BadEthSignatureEvidence{}, "gravity/BadEthSignatureEvidence", nil)
 }
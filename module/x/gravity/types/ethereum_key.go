@@ -0,0 +1,187 @@
+package types
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/subtle"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/crypto"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+)
+
+const (
+	// EthSecp256k1Type is the keyring algorithm of Ethereum keys
+	EthSecp256k1Type = hd.PubKeyType("eth_secp256k1")
+
+	// EthereumHDPath is the BIP44 derivation path of the first Ethereum account,
+	// the one wallets such as Metamask derive from a mnemonic
+	EthereumHDPath = "m/44'/60'/0'/0/0"
+
+	// EthereumPubKeyName is the amino name of EthereumPubKey
+	EthereumPubKeyName = "gravity/EthereumPubKey"
+	// EthereumPrivKeyName is the amino name of EthereumPrivKey
+	EthereumPrivKeyName = "gravity/EthereumPrivKey"
+
+	ethereumPrivKeySize = 32
+)
+
+var (
+	_ cryptotypes.PubKey  = &EthereumPubKey{}
+	_ cryptotypes.PrivKey = &EthereumPrivKey{}
+
+	// EthSecp256k1 is the keyring signing algorithm of Ethereum keys, keys are derived from a
+	// mnemonic like secp256k1 keys and only differ in their address and signatures
+	EthSecp256k1 = ethSecp256k1Algo{}
+)
+
+func init() {
+	// the keyring stores keys with the legacy amino codec, they are not registered in the interface registry
+	// so that transactions can't carry them
+	RegisterEthereumKeys(legacy.Cdc)
+}
+
+// RegisterEthereumKeys registers the Ethereum key types on an amino codec
+func RegisterEthereumKeys(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&EthereumPubKey{}, EthereumPubKeyName, nil)
+	cdc.RegisterConcrete(&EthereumPrivKey{}, EthereumPrivKeyName, nil)
+}
+
+// EthSecp256k1Option adds Ethereum keys to the signing algorithms supported by a keyring
+func EthSecp256k1Option() keyring.Option {
+	return func(options *keyring.Options) {
+		options.SupportedAlgos = append(options.SupportedAlgos, EthSecp256k1)
+	}
+}
+
+// EthAddressFromKey returns the Ethereum address of a keyring key, which must be an Ethereum key
+func EthAddressFromKey(info keyring.Info) (string, error) {
+	pubKey, ok := info.GetPubKey().(*EthereumPubKey)
+	if !ok {
+		return "", sdkerrors.Wrapf(ErrInvalid, "key %s is not an %s key", info.GetName(), EthSecp256k1Type)
+	}
+	ethAddress := pubKey.EthAddress()
+	if ethAddress == "" {
+		return "", sdkerrors.Wrapf(ErrInvalid, "key %s is malformed", info.GetName())
+	}
+	return ethAddress, nil
+}
+
+// ResolveEthAddress returns addressOrKeyName if it is an Ethereum address, otherwise the Ethereum
+// address of the Ethereum key with that name in the keyring
+func ResolveEthAddress(kr keyring.Keyring, addressOrKeyName string) (string, error) {
+	if err := ValidateEthAddress(addressOrKeyName); err == nil {
+		return addressOrKeyName, nil
+	}
+	if kr == nil {
+		return "", sdkerrors.Wrapf(ErrInvalid, "%s is not an ethereum address", addressOrKeyName)
+	}
+	info, err := kr.Key(addressOrKeyName)
+	if err != nil {
+		return "", sdkerrors.Wrapf(err, "%s is neither an ethereum address nor a key in the keyring", addressOrKeyName)
+	}
+	return EthAddressFromKey(info)
+}
+
+type ethSecp256k1Algo struct{}
+
+func (ethSecp256k1Algo) Name() hd.PubKeyType {
+	return EthSecp256k1Type
+}
+
+func (ethSecp256k1Algo) Derive() hd.DeriveFn {
+	return hd.Secp256k1.Derive()
+}
+
+func (ethSecp256k1Algo) Generate() hd.GenerateFn {
+	return func(bz []byte) cryptotypes.PrivKey {
+		key := make([]byte, ethereumPrivKeySize)
+		copy(key, bz)
+		return &EthereumPrivKey{Key: key}
+	}
+}
+
+// Address returns the Ethereum address of the key, or an empty address if the key is malformed
+func (pk *EthereumPubKey) Address() tmcrypto.Address {
+	pubKey, err := crypto.DecompressPubkey(pk.Key)
+	if err != nil {
+		return tmcrypto.Address{}
+	}
+	return tmcrypto.Address(crypto.PubkeyToAddress(*pubKey).Bytes())
+}
+
+// EthAddress returns the checksummed hex Ethereum address of the key, or an empty string if the key is malformed
+func (pk *EthereumPubKey) EthAddress() string {
+	pubKey, err := crypto.DecompressPubkey(pk.Key)
+	if err != nil {
+		return ""
+	}
+	return crypto.PubkeyToAddress(*pubKey).Hex()
+}
+
+// Bytes returns the compressed key
+func (pk *EthereumPubKey) Bytes() []byte {
+	return pk.Key
+}
+
+// VerifySignature checks that an Ethereum signature, as made by NewEthereumSignature, of msg was made
+// by this key
+func (pk *EthereumPubKey) VerifySignature(msg []byte, sig []byte) bool {
+	// EthAddressFromSignature normalizes the signature in place
+	signature := append([]byte{}, sig...)
+	return ValidateEthereumSignature(msg, signature, pk.EthAddress()) == nil
+}
+
+func (pk *EthereumPubKey) Equals(other cryptotypes.PubKey) bool {
+	return pk.Type() == other.Type() && bytes.Equal(pk.Bytes(), other.Bytes())
+}
+
+func (pk *EthereumPubKey) Type() string {
+	return string(EthSecp256k1Type)
+}
+
+func (pk *EthereumPubKey) String() string {
+	return fmt.Sprintf("EthereumPubKey{%X}", pk.Key)
+}
+
+// Bytes returns the raw private key
+func (pk *EthereumPrivKey) Bytes() []byte {
+	return pk.Key
+}
+
+// Sign signs msg, usually a checkpoint, with the Ethereum signed message prefix
+func (pk *EthereumPrivKey) Sign(msg []byte) ([]byte, error) {
+	privateKey, err := pk.ToECDSA()
+	if err != nil {
+		return nil, err
+	}
+	return NewEthereumSignature(msg, privateKey)
+}
+
+// PubKey returns the compressed public key of the key
+func (pk *EthereumPrivKey) PubKey() cryptotypes.PubKey {
+	privateKey, err := pk.ToECDSA()
+	if err != nil {
+		panic(err)
+	}
+	return &EthereumPubKey{Key: crypto.CompressPubkey(&privateKey.PublicKey)}
+}
+
+func (pk *EthereumPrivKey) Equals(other cryptotypes.LedgerPrivKey) bool {
+	return pk.Type() == other.Type() && subtle.ConstantTimeCompare(pk.Bytes(), other.Bytes()) == 1
+}
+
+func (pk *EthereumPrivKey) Type() string {
+	return string(EthSecp256k1Type)
+}
+
+// ToECDSA returns the key as a go-ethereum private key
+func (pk *EthereumPrivKey) ToECDSA() (*ecdsa.PrivateKey, error) {
+	return crypto.ToECDSA(pk.Key)
+}
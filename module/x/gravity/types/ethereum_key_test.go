@@ -0,0 +1,92 @@
+package types
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdkcrypto "github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEthereumKeyInKeyring(t *testing.T) {
+	const (
+		// the development mnemonic of hardhat and many other Ethereum tools
+		mnemonic   = "test test test test test test test test test test test junk"
+		ethAddress = "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"
+	)
+
+	// the keyring refuses unsupported algorithms
+	kr, err := keyring.New("gravity", keyring.BackendTest, t.TempDir(), nil)
+	require.NoError(t, err)
+	_, err = kr.NewAccount("eth", mnemonic, "", EthereumHDPath, EthSecp256k1)
+	require.Error(t, err)
+
+	dir := t.TempDir()
+	kr, err = keyring.New("gravity", keyring.BackendTest, dir, nil, EthSecp256k1Option())
+	require.NoError(t, err)
+	info, err := kr.NewAccount("eth", mnemonic, "", EthereumHDPath, EthSecp256k1)
+	require.NoError(t, err)
+	_, err = kr.NewAccount("cosmos", mnemonic, "", hd.CreateHDPath(118, 0, 0).String(), hd.Secp256k1)
+	require.NoError(t, err)
+
+	addr, err := EthAddressFromKey(info)
+	require.NoError(t, err)
+	assert.Equal(t, ethAddress, addr)
+
+	// the key is read back with the key types registered on the legacy codec, without the option
+	kr, err = keyring.New("gravity", keyring.BackendTest, dir, nil)
+	require.NoError(t, err)
+
+	addr, err = ResolveEthAddress(kr, "eth")
+	require.NoError(t, err)
+	assert.Equal(t, ethAddress, addr)
+	addr, err = ResolveEthAddress(kr, ethAddress)
+	require.NoError(t, err)
+	assert.Equal(t, ethAddress, addr)
+	_, err = ResolveEthAddress(kr, "cosmos")
+	assert.Error(t, err)
+	_, err = ResolveEthAddress(kr, "missing")
+	assert.Error(t, err)
+
+	// keyring signatures are orchestrator signatures
+	checkpoint := crypto.Keccak256([]byte("checkpoint"))
+	signature, pubKey, err := kr.Sign("eth", checkpoint)
+	require.NoError(t, err)
+	assert.NoError(t, ValidateEthereumSignature(checkpoint, signature, ethAddress))
+	assert.True(t, pubKey.VerifySignature(checkpoint, signature))
+	assert.False(t, pubKey.VerifySignature(crypto.Keccak256([]byte("other")), signature))
+
+	// exported keys can be imported back
+	armor, err := kr.ExportPrivKeyArmor("eth", "passphrase")
+	require.NoError(t, err)
+	require.NoError(t, kr.Delete("eth"))
+	require.NoError(t, kr.ImportPrivKey("eth", armor, "passphrase"))
+	privKey, algo, err := sdkcrypto.UnarmorDecryptPrivKey(armor, "passphrase")
+	require.NoError(t, err)
+	assert.Equal(t, string(EthSecp256k1Type), algo)
+	assert.True(t, privKey.PubKey().Equals(pubKey))
+	addr, err = ResolveEthAddress(kr, "eth")
+	require.NoError(t, err)
+	assert.Equal(t, ethAddress, addr)
+}
+
+func TestMalformedEthereumPubKey(t *testing.T) {
+	pubKey := &EthereumPubKey{Key: []byte{0x1, 0x2, 0x3}}
+	assert.Empty(t, pubKey.Address())
+	assert.Empty(t, pubKey.EthAddress())
+	assert.False(t, pubKey.VerifySignature(crypto.Keccak256([]byte("checkpoint")), make([]byte, 65)))
+}
+
+func TestEthereumKeysNotInInterfaceRegistry(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	RegisterInterfaces(registry)
+	_, err := registry.Resolve("/" + proto.MessageName(&EthereumPubKey{}))
+	assert.Error(t, err)
+	_, err = registry.Resolve("/" + proto.MessageName(&EthereumPrivKey{}))
+	assert.Error(t, err)
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"encoding/hex"
+	"strings"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+)
+
+const (
+	// RouteBadEthSignatureEvidence is the x/evidence route of a BadEthSignatureEvidence
+	RouteBadEthSignatureEvidence = "BadEthSignature"
+	// TypeBadEthSignatureEvidence is the x/evidence type of a BadEthSignatureEvidence
+	TypeBadEthSignatureEvidence = "BadEthSignatureEvidence"
+)
+
+var (
+	_ exported.Evidence                  = &BadEthSignatureEvidence{}
+	_ codectypes.UnpackInterfacesMessage = &BadEthSignatureEvidence{}
+)
+
+// NewBadEthSignatureEvidence returns the evidence submitted by a MsgSubmitBadSignatureEvidence, the message
+// carries no height so the infraction is taken to be at the given height
+func NewBadEthSignatureEvidence(msg *MsgSubmitBadSignatureEvidence, height int64) *BadEthSignatureEvidence {
+	return &BadEthSignatureEvidence{
+		Subject:   msg.Subject,
+		Signature: msg.Signature,
+		Height:    height,
+		Sender:    msg.Sender,
+	}
+}
+
+// Route returns the x/evidence route of the evidence
+func (e *BadEthSignatureEvidence) Route() string { return RouteBadEthSignatureEvidence }
+
+// Type returns the x/evidence type of the evidence
+func (e *BadEthSignatureEvidence) Type() string { return TypeBadEthSignatureEvidence }
+
+// Hash returns the hash of the evidence, x/evidence uses this to reject evidence it has already seen
+func (e *BadEthSignatureEvidence) Hash() tmbytes.HexBytes {
+	bz, err := e.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return tmhash.Sum(bz)
+}
+
+// ValidateBasic performs stateless checks
+func (e *BadEthSignatureEvidence) ValidateBasic() error {
+	if e.Subject == nil {
+		return sdkerrors.Wrap(ErrEmpty, "subject")
+	}
+	if _, err := hex.DecodeString(strings.TrimPrefix(e.Signature, "0x")); err != nil || len(e.Signature) == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "signature")
+	}
+	if e.Height <= 0 {
+		return sdkerrors.Wrap(ErrInvalid, "height")
+	}
+	if _, err := sdk.AccAddressFromBech32(e.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (e *BadEthSignatureEvidence) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var subject EthereumSigned
+	return unpacker.UnpackAny(e.Subject, &subject)
+}
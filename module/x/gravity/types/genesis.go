@@ -66,6 +66,47 @@ var (
 	// to a relayer when they relay a valset
 	ParamStoreValsetRewardAmount = []byte("ValsetReward")
 
+	// ParamStoreBatchTimeoutSuspensionThreshold stores the number of consecutive batch timeouts
+	// after which a token is suspended
+	ParamStoreBatchTimeoutSuspensionThreshold = []byte("BatchTimeoutSuspensionThreshold")
+
+	// ParamStoreMaxSlashingItemsPerBlock stores the maximum number of valsets, batches and logic calls
+	// each checked for slashing in a single block
+	ParamStoreMaxSlashingItemsPerBlock = []byte("MaxSlashingItemsPerBlock")
+
+	// ParamStoreConfirmsWindow stores the number of items of each confirm type over which liveness is tracked
+	ParamStoreConfirmsWindow = []byte("ConfirmsWindow")
+
+	// ParamStoreMinConfirmsPerWindow stores the fraction of the confirms window a validator must confirm
+	ParamStoreMinConfirmsPerWindow = []byte("MinConfirmsPerWindow")
+
+	// ParamStoreJailForMissedConfirms stores whether validators are jailed for missing too many confirms
+	ParamStoreJailForMissedConfirms = []byte("JailForMissedConfirms")
+
+	// ParamStoreBadSignatureEvidenceBounty stores the share of the slashed stake paid to the sender of bad signature evidence
+	ParamStoreBadSignatureEvidenceBounty = []byte("BadSignatureEvidenceBounty")
+
+	// ParamStoreKeyRegistrationGracePeriod stores the number of blocks a newly bonded validator has to register delegate keys
+	ParamStoreKeyRegistrationGracePeriod = []byte("KeyRegistrationGracePeriod")
+
+	// ParamStoreMaxBridgeValidators stores the number of validators in the bridge validator set
+	ParamStoreMaxBridgeValidators = []byte("MaxBridgeValidators")
+
+	// ParamStoreMinBridgePower stores the share of the total bonded power the bridge validator set must hold
+	ParamStoreMinBridgePower = []byte("MinBridgePower")
+
+	// ParamStoreMaxValsetAge stores the number of blocks after which a new valset is requested regardless of power changes
+	ParamStoreMaxValsetAge = []byte("MaxValsetAge")
+
+	// ParamStoreAttestationsToKeep stores the number of event nonces for which attestations are kept
+	ParamStoreAttestationsToKeep = []byte("AttestationsToKeep")
+
+	// ParamStoreFeeExemptOrchestratorMsgs stores the number of orchestrator messages per block that are exempt from fees
+	ParamStoreFeeExemptOrchestratorMsgs = []byte("FeeExemptOrchestratorMsgs")
+
+	// ParamStoreFeeExemptMaxGas stores the highest gas limit of a transaction that is exempt from fees
+	ParamStoreFeeExemptMaxGas = []byte("FeeExemptMaxGas")
+
 	// Ensure that params implements the proper interface
 	_ paramtypes.ParamSet = &Params{
 		GravityId:                    "",
@@ -87,6 +128,19 @@ var (
 			Denom:  "",
 			Amount: sdk.Int{},
 		},
+		BatchTimeoutSuspensionThreshold: 0,
+		MaxSlashingItemsPerBlock:        0,
+		ConfirmsWindow:                  0,
+		MinConfirmsPerWindow:            sdk.Dec{},
+		JailForMissedConfirms:           false,
+		BadSignatureEvidenceBounty:      sdk.Dec{},
+		KeyRegistrationGracePeriod:      0,
+		MaxBridgeValidators:             0,
+		MinBridgePower:                  sdk.Dec{},
+		MaxValsetAge:                    0,
+		AttestationsToKeep:              0,
+		FeeExemptOrchestratorMsgs:       0,
+		FeeExemptMaxGas:                 0,
 	}
 )
 
@@ -115,28 +169,46 @@ func DefaultGenesisState() *GenesisState {
 		DelegateKeys:       []*MsgSetOrchestratorAddress{},
 		Erc20ToDenoms:      []*ERC20ToDenom{},
 		UnbatchedTransfers: []*OutgoingTransferTx{},
+		SuspendedTokens:    []string{},
+
+		BadSignatureEvidence:   []BadSignatureEvidence{},
+		ObservedEventRecords:   []ObservedEventRecord{},
+		PendingDepositForwards: []PendingDepositForward{},
 	}
 }
 
 // DefaultParams returns a copy of the default params
 func DefaultParams() *Params {
 	return &Params{
-		GravityId:                    "defaultgravityid",
-		ContractSourceHash:           "",
-		BridgeEthereumAddress:        "",
-		BridgeChainId:                0,
-		SignedValsetsWindow:          10000,
-		SignedBatchesWindow:          10000,
-		SignedLogicCallsWindow:       10000,
-		TargetBatchTimeout:           43200000,
-		AverageBlockTime:             5000,
-		AverageEthereumBlockTime:     15000,
-		SlashFractionValset:          sdk.NewDec(1).Quo(sdk.NewDec(1000)),
-		SlashFractionBatch:           sdk.NewDec(1).Quo(sdk.NewDec(1000)),
-		SlashFractionLogicCall:       sdk.NewDec(1).Quo(sdk.NewDec(1000)),
-		UnbondSlashingValsetsWindow:  10000,
-		SlashFractionBadEthSignature: sdk.NewDec(1).Quo(sdk.NewDec(1000)),
-		ValsetReward:                 sdk.Coin{Denom: "", Amount: sdk.ZeroInt()},
+		GravityId:                       "defaultgravityid",
+		ContractSourceHash:              "",
+		BridgeEthereumAddress:           "",
+		BridgeChainId:                   0,
+		SignedValsetsWindow:             10000,
+		SignedBatchesWindow:             10000,
+		SignedLogicCallsWindow:          10000,
+		TargetBatchTimeout:              43200000,
+		AverageBlockTime:                5000,
+		AverageEthereumBlockTime:        15000,
+		SlashFractionValset:             sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		SlashFractionBatch:              sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		SlashFractionLogicCall:          sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		UnbondSlashingValsetsWindow:     10000,
+		SlashFractionBadEthSignature:    sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		ValsetReward:                    sdk.Coin{Denom: "", Amount: sdk.ZeroInt()},
+		BatchTimeoutSuspensionThreshold: 5,
+		MaxSlashingItemsPerBlock:        10,
+		ConfirmsWindow:                  100,
+		MinConfirmsPerWindow:            sdk.NewDecWithPrec(5, 1),
+		JailForMissedConfirms:           true,
+		BadSignatureEvidenceBounty:      sdk.NewDecWithPrec(1, 1),
+		KeyRegistrationGracePeriod:      1000,
+		MaxBridgeValidators:             100,
+		MinBridgePower:                  sdk.NewDecWithPrec(9, 1),
+		MaxValsetAge:                    120960,
+		AttestationsToKeep:              1000,
+		FeeExemptOrchestratorMsgs:       20,
+		FeeExemptMaxGas:                 2000000,
 	}
 }
 
@@ -190,6 +262,45 @@ func (p Params) ValidateBasic() error {
 	if err := validateValsetRewardAmount(p.ValsetReward); err != nil {
 		return sdkerrors.Wrap(err, "ValsetReward amount")
 	}
+	if err := validateBatchTimeoutSuspensionThreshold(p.BatchTimeoutSuspensionThreshold); err != nil {
+		return sdkerrors.Wrap(err, "batch timeout suspension threshold")
+	}
+	if err := validateMaxSlashingItemsPerBlock(p.MaxSlashingItemsPerBlock); err != nil {
+		return sdkerrors.Wrap(err, "max slashing items per block")
+	}
+	if err := validateConfirmsWindow(p.ConfirmsWindow); err != nil {
+		return sdkerrors.Wrap(err, "confirms window")
+	}
+	if err := validateMinConfirmsPerWindow(p.MinConfirmsPerWindow); err != nil {
+		return sdkerrors.Wrap(err, "min confirms per window")
+	}
+	if err := validateJailForMissedConfirms(p.JailForMissedConfirms); err != nil {
+		return sdkerrors.Wrap(err, "jail for missed confirms")
+	}
+	if err := validateBadSignatureEvidenceBounty(p.BadSignatureEvidenceBounty); err != nil {
+		return sdkerrors.Wrap(err, "bad signature evidence bounty")
+	}
+	if err := validateKeyRegistrationGracePeriod(p.KeyRegistrationGracePeriod); err != nil {
+		return sdkerrors.Wrap(err, "key registration grace period")
+	}
+	if err := validateMaxBridgeValidators(p.MaxBridgeValidators); err != nil {
+		return sdkerrors.Wrap(err, "max bridge validators")
+	}
+	if err := validateMinBridgePower(p.MinBridgePower); err != nil {
+		return sdkerrors.Wrap(err, "min bridge power")
+	}
+	if err := validateMaxValsetAge(p.MaxValsetAge); err != nil {
+		return sdkerrors.Wrap(err, "max valset age")
+	}
+	if err := validateAttestationsToKeep(p.AttestationsToKeep); err != nil {
+		return sdkerrors.Wrap(err, "attestations to keep")
+	}
+	if err := validateFeeExemptOrchestratorMsgs(p.FeeExemptOrchestratorMsgs); err != nil {
+		return sdkerrors.Wrap(err, "fee exempt orchestrator msgs")
+	}
+	if err := validateFeeExemptMaxGas(p.FeeExemptMaxGas); err != nil {
+		return sdkerrors.Wrap(err, "fee exempt max gas")
+	}
 
 	return nil
 }
@@ -216,6 +327,19 @@ func ParamKeyTable() paramtypes.KeyTable {
 			Denom:  "",
 			Amount: sdk.Int{},
 		},
+		BatchTimeoutSuspensionThreshold: 0,
+		MaxSlashingItemsPerBlock:        0,
+		ConfirmsWindow:                  0,
+		MinConfirmsPerWindow:            sdk.Dec{},
+		JailForMissedConfirms:           false,
+		BadSignatureEvidenceBounty:      sdk.Dec{},
+		KeyRegistrationGracePeriod:      0,
+		MaxBridgeValidators:             0,
+		MinBridgePower:                  sdk.Dec{},
+		MaxValsetAge:                    0,
+		AttestationsToKeep:              0,
+		FeeExemptOrchestratorMsgs:       0,
+		FeeExemptMaxGas:                 0,
 	})
 }
 
@@ -238,6 +362,19 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreUnbondSlashingValsetsWindow, &p.UnbondSlashingValsetsWindow, validateUnbondSlashingValsetsWindow),
 		paramtypes.NewParamSetPair(ParamStoreSlashFractionBadEthSignature, &p.SlashFractionBadEthSignature, validateSlashFractionBadEthSignature),
 		paramtypes.NewParamSetPair(ParamStoreValsetRewardAmount, &p.ValsetReward, validateValsetRewardAmount),
+		paramtypes.NewParamSetPair(ParamStoreBatchTimeoutSuspensionThreshold, &p.BatchTimeoutSuspensionThreshold, validateBatchTimeoutSuspensionThreshold),
+		paramtypes.NewParamSetPair(ParamStoreMaxSlashingItemsPerBlock, &p.MaxSlashingItemsPerBlock, validateMaxSlashingItemsPerBlock),
+		paramtypes.NewParamSetPair(ParamStoreConfirmsWindow, &p.ConfirmsWindow, validateConfirmsWindow),
+		paramtypes.NewParamSetPair(ParamStoreMinConfirmsPerWindow, &p.MinConfirmsPerWindow, validateMinConfirmsPerWindow),
+		paramtypes.NewParamSetPair(ParamStoreJailForMissedConfirms, &p.JailForMissedConfirms, validateJailForMissedConfirms),
+		paramtypes.NewParamSetPair(ParamStoreBadSignatureEvidenceBounty, &p.BadSignatureEvidenceBounty, validateBadSignatureEvidenceBounty),
+		paramtypes.NewParamSetPair(ParamStoreKeyRegistrationGracePeriod, &p.KeyRegistrationGracePeriod, validateKeyRegistrationGracePeriod),
+		paramtypes.NewParamSetPair(ParamStoreMaxBridgeValidators, &p.MaxBridgeValidators, validateMaxBridgeValidators),
+		paramtypes.NewParamSetPair(ParamStoreMinBridgePower, &p.MinBridgePower, validateMinBridgePower),
+		paramtypes.NewParamSetPair(ParamStoreMaxValsetAge, &p.MaxValsetAge, validateMaxValsetAge),
+		paramtypes.NewParamSetPair(ParamStoreAttestationsToKeep, &p.AttestationsToKeep, validateAttestationsToKeep),
+		paramtypes.NewParamSetPair(ParamStoreFeeExemptOrchestratorMsgs, &p.FeeExemptOrchestratorMsgs, validateFeeExemptOrchestratorMsgs),
+		paramtypes.NewParamSetPair(ParamStoreFeeExemptMaxGas, &p.FeeExemptMaxGas, validateFeeExemptMaxGas),
 	}
 }
 
@@ -390,6 +527,122 @@ func validateValsetRewardAmount(i interface{}) error {
 	return nil
 }
 
+func validateBatchTimeoutSuspensionThreshold(i interface{}) error {
+	// zero is valid and disables token suspension
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxSlashingItemsPerBlock(i interface{}) error {
+	val, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	} else if val == 0 {
+		return fmt.Errorf("max slashing items per block must be greater than zero")
+	}
+	return nil
+}
+
+func validateConfirmsWindow(i interface{}) error {
+	val, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	} else if val == 0 {
+		return fmt.Errorf("confirms window must be greater than zero")
+	}
+	return nil
+}
+
+func validateMinConfirmsPerWindow(i interface{}) error {
+	val, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	} else if val.IsNil() || val.IsNegative() || val.GT(sdk.OneDec()) {
+		return fmt.Errorf("min confirms per window must be between zero and one")
+	}
+	return nil
+}
+
+func validateJailForMissedConfirms(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateBadSignatureEvidenceBounty(i interface{}) error {
+	val, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	} else if val.IsNil() || val.IsNegative() || val.GT(sdk.OneDec()) {
+		return fmt.Errorf("bad signature evidence bounty must be between zero and one")
+	}
+	return nil
+}
+
+func validateKeyRegistrationGracePeriod(i interface{}) error {
+	val, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	} else if val == 0 {
+		return fmt.Errorf("key registration grace period must be positive")
+	}
+	return nil
+}
+
+func validateMaxBridgeValidators(i interface{}) error {
+	val, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	} else if val == 0 {
+		return fmt.Errorf("max bridge validators must be positive")
+	}
+	return nil
+}
+
+func validateMinBridgePower(i interface{}) error {
+	val, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	} else if val.IsNil() || !val.IsPositive() || val.GT(sdk.OneDec()) {
+		return fmt.Errorf("min bridge power must be greater than zero and at most one")
+	}
+	return nil
+}
+
+func validateMaxValsetAge(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateAttestationsToKeep(i interface{}) error {
+	val, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	} else if val == 0 {
+		return fmt.Errorf("attestations to keep must be positive")
+	}
+	return nil
+}
+
+func validateFeeExemptOrchestratorMsgs(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateFeeExemptMaxGas(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
 func strToFixByteArray(s string) ([32]byte, error) {
 	var out [32]byte
 	if len([]byte(s)) > 32 {
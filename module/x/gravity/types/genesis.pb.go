@@ -45,23 +45,111 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // the token you are using for validator set rewards valset updates will fail and the bridge
 // will be vulnerable to highjacking. For these paramaters the zero values are special and indicate
 // not to attempt any reward. This is the default for bootstrapping.
+//
+// batch_timeout_suspension_threshold
+//
+// The number of consecutive batches for a single token that may time out before that
+// token is suspended. An ERC20 that reverts every transfer (paused, blacklisted destination)
+// will otherwise cause the same transactions to be batched, timed out and returned to the pool
+// forever. Once suspended MsgSendToEth is rejected for the token and its pool is refunded to the
+// senders, only a governance proposal can lift the suspension. Zero disables this check.
+//
+// max_slashing_items_per_block
+//
+// The maximum number of valsets, batches and logic calls each checked for missing
+// confirmations in a single block. After a long outage many items may be ready to
+// slash at once, the remainder are checked in the following blocks.
+//
+// confirms_window
+// min_confirms_per_window
+// jail_for_missed_confirms
+//
+// Confirm liveness is tracked in the same way as x/slashing tracks signed blocks. For each of
+// valsets, batches and logic calls the last confirms_window items each validator was expected to
+// confirm are recorded, a validator is slashed by the slash fraction for that type once it has
+// confirmed fewer than min_confirms_per_window of them, this gives validators room to miss the
+// occasional confirm during a restart. When jail_for_missed_confirms is set validators are also
+// jailed. Unbonding validators are held to the same standard for unbond_slashing_valsets_window
+// blocks after they start unbonding.
+//
+// bad_signature_evidence_bounty
+//
+// The share of the stake slashed for a bad Ethereum signature that is paid to the sender of the
+// evidence, without a reward nobody has a reason to watch Ethereum for fraudulent signatures.
+//
+// key_registration_grace_period
+//
+// The number of blocks a validator has after bonding to register its delegate keys with
+// MsgSetOrchestratorAddress. Validators without keys are not part of the bridge validator set and
+// are not slashed for missing confirms, if they have not registered keys by the end of the grace
+// period they are jailed.
+//
+// max_bridge_validators
+//
+// The number of validators in the bridge validator set. Ethereum verifies a signature for every
+// member when relaying a valset or batch so large sets are expensive to relay, only the most
+// powerful validators with Ethereum keys are included.
+//
+// min_bridge_power
+//
+// The share of the total bonded power that the bridge validator set must hold, more than
+// max_bridge_validators members are included when required to reach it, up to all validators
+// with Ethereum keys.
+// Powers in the valset are normalized over its members, only members are expected to sign
+// valsets, batches and logic calls or have their claims counted towards attestations.
+//
+// max_valset_age
+//
+// The number of blocks after which a new valset is requested even if the power of the validators
+// has barely changed, so the set on Ethereum doesn't slowly drift away from the Cosmos validators.
+// Zero disables the age limit.
+//
+// attestations_to_keep
+//
+// The number of event nonces before the last observed one for which attestations and
+// their votes are kept, older attestations are pruned. Observed events remain in the
+// archive of observed event records.
+//
+// fee_exempt_orchestrator_msgs
+//
+// The number of confirms and claims each orchestrator can submit per block without paying
+// fees, as long as its transactions contain only such messages. Transactions over the quota
+// pay fees as usual. Zero disables the exemption.
+//
+// fee_exempt_max_gas
+//
+// The highest gas limit a transaction can have and still be exempt from fees, so that exempt
+// transactions can't take up a large share of a block for free.
 type Params struct {
-	GravityId                    string                                 `protobuf:"bytes,1,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty"`
-	ContractSourceHash           string                                 `protobuf:"bytes,2,opt,name=contract_source_hash,json=contractSourceHash,proto3" json:"contract_source_hash,omitempty"`
-	BridgeEthereumAddress        string                                 `protobuf:"bytes,4,opt,name=bridge_ethereum_address,json=bridgeEthereumAddress,proto3" json:"bridge_ethereum_address,omitempty"`
-	BridgeChainId                uint64                                 `protobuf:"varint,5,opt,name=bridge_chain_id,json=bridgeChainId,proto3" json:"bridge_chain_id,omitempty"`
-	SignedValsetsWindow          uint64                                 `protobuf:"varint,6,opt,name=signed_valsets_window,json=signedValsetsWindow,proto3" json:"signed_valsets_window,omitempty"`
-	SignedBatchesWindow          uint64                                 `protobuf:"varint,7,opt,name=signed_batches_window,json=signedBatchesWindow,proto3" json:"signed_batches_window,omitempty"`
-	SignedLogicCallsWindow       uint64                                 `protobuf:"varint,8,opt,name=signed_logic_calls_window,json=signedLogicCallsWindow,proto3" json:"signed_logic_calls_window,omitempty"`
-	TargetBatchTimeout           uint64                                 `protobuf:"varint,9,opt,name=target_batch_timeout,json=targetBatchTimeout,proto3" json:"target_batch_timeout,omitempty"`
-	AverageBlockTime             uint64                                 `protobuf:"varint,10,opt,name=average_block_time,json=averageBlockTime,proto3" json:"average_block_time,omitempty"`
-	AverageEthereumBlockTime     uint64                                 `protobuf:"varint,11,opt,name=average_ethereum_block_time,json=averageEthereumBlockTime,proto3" json:"average_ethereum_block_time,omitempty"`
-	SlashFractionValset          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=slash_fraction_valset,json=slashFractionValset,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_valset"`
-	SlashFractionBatch           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=slash_fraction_batch,json=slashFractionBatch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_batch"`
-	SlashFractionLogicCall       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=slash_fraction_logic_call,json=slashFractionLogicCall,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_logic_call"`
-	UnbondSlashingValsetsWindow  uint64                                 `protobuf:"varint,15,opt,name=unbond_slashing_valsets_window,json=unbondSlashingValsetsWindow,proto3" json:"unbond_slashing_valsets_window,omitempty"`
-	SlashFractionBadEthSignature github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=slash_fraction_bad_eth_signature,json=slashFractionBadEthSignature,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_bad_eth_signature"`
-	ValsetReward                 types.Coin                             `protobuf:"bytes,17,opt,name=valset_reward,json=valsetReward,proto3" json:"valset_reward"`
+	GravityId                       string                                 `protobuf:"bytes,1,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty"`
+	ContractSourceHash              string                                 `protobuf:"bytes,2,opt,name=contract_source_hash,json=contractSourceHash,proto3" json:"contract_source_hash,omitempty"`
+	BridgeEthereumAddress           string                                 `protobuf:"bytes,4,opt,name=bridge_ethereum_address,json=bridgeEthereumAddress,proto3" json:"bridge_ethereum_address,omitempty"`
+	BridgeChainId                   uint64                                 `protobuf:"varint,5,opt,name=bridge_chain_id,json=bridgeChainId,proto3" json:"bridge_chain_id,omitempty"`
+	SignedValsetsWindow             uint64                                 `protobuf:"varint,6,opt,name=signed_valsets_window,json=signedValsetsWindow,proto3" json:"signed_valsets_window,omitempty"`
+	SignedBatchesWindow             uint64                                 `protobuf:"varint,7,opt,name=signed_batches_window,json=signedBatchesWindow,proto3" json:"signed_batches_window,omitempty"`
+	SignedLogicCallsWindow          uint64                                 `protobuf:"varint,8,opt,name=signed_logic_calls_window,json=signedLogicCallsWindow,proto3" json:"signed_logic_calls_window,omitempty"`
+	TargetBatchTimeout              uint64                                 `protobuf:"varint,9,opt,name=target_batch_timeout,json=targetBatchTimeout,proto3" json:"target_batch_timeout,omitempty"`
+	AverageBlockTime                uint64                                 `protobuf:"varint,10,opt,name=average_block_time,json=averageBlockTime,proto3" json:"average_block_time,omitempty"`
+	AverageEthereumBlockTime        uint64                                 `protobuf:"varint,11,opt,name=average_ethereum_block_time,json=averageEthereumBlockTime,proto3" json:"average_ethereum_block_time,omitempty"`
+	SlashFractionValset             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=slash_fraction_valset,json=slashFractionValset,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_valset"`
+	SlashFractionBatch              github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=slash_fraction_batch,json=slashFractionBatch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_batch"`
+	SlashFractionLogicCall          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=slash_fraction_logic_call,json=slashFractionLogicCall,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_logic_call"`
+	UnbondSlashingValsetsWindow     uint64                                 `protobuf:"varint,15,opt,name=unbond_slashing_valsets_window,json=unbondSlashingValsetsWindow,proto3" json:"unbond_slashing_valsets_window,omitempty"`
+	SlashFractionBadEthSignature    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=slash_fraction_bad_eth_signature,json=slashFractionBadEthSignature,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_bad_eth_signature"`
+	ValsetReward                    types.Coin                             `protobuf:"bytes,17,opt,name=valset_reward,json=valsetReward,proto3" json:"valset_reward"`
+	BatchTimeoutSuspensionThreshold uint64                                 `protobuf:"varint,18,opt,name=batch_timeout_suspension_threshold,json=batchTimeoutSuspensionThreshold,proto3" json:"batch_timeout_suspension_threshold,omitempty"`
+	MaxSlashingItemsPerBlock        uint64                                 `protobuf:"varint,19,opt,name=max_slashing_items_per_block,json=maxSlashingItemsPerBlock,proto3" json:"max_slashing_items_per_block,omitempty"`
+	ConfirmsWindow                  uint64                                 `protobuf:"varint,20,opt,name=confirms_window,json=confirmsWindow,proto3" json:"confirms_window,omitempty"`
+	MinConfirmsPerWindow            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,21,opt,name=min_confirms_per_window,json=minConfirmsPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_confirms_per_window"`
+	JailForMissedConfirms           bool                                   `protobuf:"varint,22,opt,name=jail_for_missed_confirms,json=jailForMissedConfirms,proto3" json:"jail_for_missed_confirms,omitempty"`
+	BadSignatureEvidenceBounty      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,23,opt,name=bad_signature_evidence_bounty,json=badSignatureEvidenceBounty,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bad_signature_evidence_bounty"`
+	KeyRegistrationGracePeriod      uint64                                 `protobuf:"varint,24,opt,name=key_registration_grace_period,json=keyRegistrationGracePeriod,proto3" json:"key_registration_grace_period,omitempty"`
+	MaxBridgeValidators             uint64                                 `protobuf:"varint,25,opt,name=max_bridge_validators,json=maxBridgeValidators,proto3" json:"max_bridge_validators,omitempty"`
+	MinBridgePower                  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,26,opt,name=min_bridge_power,json=minBridgePower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_bridge_power"`
+	MaxValsetAge                    uint64                                 `protobuf:"varint,27,opt,name=max_valset_age,json=maxValsetAge,proto3" json:"max_valset_age,omitempty"`
+	AttestationsToKeep              uint64                                 `protobuf:"varint,28,opt,name=attestations_to_keep,json=attestationsToKeep,proto3" json:"attestations_to_keep,omitempty"`
+	FeeExemptOrchestratorMsgs       uint64                                 `protobuf:"varint,29,opt,name=fee_exempt_orchestrator_msgs,json=feeExemptOrchestratorMsgs,proto3" json:"fee_exempt_orchestrator_msgs,omitempty"`
+	FeeExemptMaxGas                 uint64                                 `protobuf:"varint,30,opt,name=fee_exempt_max_gas,json=feeExemptMaxGas,proto3" json:"fee_exempt_max_gas,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -181,20 +269,95 @@ func (m *Params) GetValsetReward() types.Coin {
 	return types.Coin{}
 }
 
+func (m *Params) GetBatchTimeoutSuspensionThreshold() uint64 {
+	if m != nil {
+		return m.BatchTimeoutSuspensionThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSlashingItemsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxSlashingItemsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetConfirmsWindow() uint64 {
+	if m != nil {
+		return m.ConfirmsWindow
+	}
+	return 0
+}
+
+func (m *Params) GetJailForMissedConfirms() bool {
+	if m != nil {
+		return m.JailForMissedConfirms
+	}
+	return false
+}
+
+func (m *Params) GetKeyRegistrationGracePeriod() uint64 {
+	if m != nil {
+		return m.KeyRegistrationGracePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMaxBridgeValidators() uint64 {
+	if m != nil {
+		return m.MaxBridgeValidators
+	}
+	return 0
+}
+
+func (m *Params) GetMaxValsetAge() uint64 {
+	if m != nil {
+		return m.MaxValsetAge
+	}
+	return 0
+}
+
+func (m *Params) GetAttestationsToKeep() uint64 {
+	if m != nil {
+		return m.AttestationsToKeep
+	}
+	return 0
+}
+
+func (m *Params) GetFeeExemptOrchestratorMsgs() uint64 {
+	if m != nil {
+		return m.FeeExemptOrchestratorMsgs
+	}
+	return 0
+}
+
+func (m *Params) GetFeeExemptMaxGas() uint64 {
+	if m != nil {
+		return m.FeeExemptMaxGas
+	}
+	return 0
+}
+
 // GenesisState struct
 type GenesisState struct {
-	Params             *Params                      `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	LastObservedNonce  uint64                       `protobuf:"varint,2,opt,name=last_observed_nonce,json=lastObservedNonce,proto3" json:"last_observed_nonce,omitempty"`
-	Valsets            []*Valset                    `protobuf:"bytes,3,rep,name=valsets,proto3" json:"valsets,omitempty"`
-	ValsetConfirms     []*MsgValsetConfirm          `protobuf:"bytes,4,rep,name=valset_confirms,json=valsetConfirms,proto3" json:"valset_confirms,omitempty"`
-	Batches            []*OutgoingTxBatch           `protobuf:"bytes,5,rep,name=batches,proto3" json:"batches,omitempty"`
-	BatchConfirms      []MsgConfirmBatch            `protobuf:"bytes,6,rep,name=batch_confirms,json=batchConfirms,proto3" json:"batch_confirms"`
-	LogicCalls         []*OutgoingLogicCall         `protobuf:"bytes,7,rep,name=logic_calls,json=logicCalls,proto3" json:"logic_calls,omitempty"`
-	LogicCallConfirms  []MsgConfirmLogicCall        `protobuf:"bytes,8,rep,name=logic_call_confirms,json=logicCallConfirms,proto3" json:"logic_call_confirms"`
-	Attestations       []Attestation                `protobuf:"bytes,9,rep,name=attestations,proto3" json:"attestations"`
-	DelegateKeys       []*MsgSetOrchestratorAddress `protobuf:"bytes,10,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys,omitempty"`
-	Erc20ToDenoms      []*ERC20ToDenom              `protobuf:"bytes,11,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms,omitempty"`
-	UnbatchedTransfers []*OutgoingTransferTx        `protobuf:"bytes,12,rep,name=unbatched_transfers,json=unbatchedTransfers,proto3" json:"unbatched_transfers,omitempty"`
+	Params                          *Params                      `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	LastObservedNonce               uint64                       `protobuf:"varint,2,opt,name=last_observed_nonce,json=lastObservedNonce,proto3" json:"last_observed_nonce,omitempty"`
+	Valsets                         []*Valset                    `protobuf:"bytes,3,rep,name=valsets,proto3" json:"valsets,omitempty"`
+	ValsetConfirms                  []*MsgValsetConfirm          `protobuf:"bytes,4,rep,name=valset_confirms,json=valsetConfirms,proto3" json:"valset_confirms,omitempty"`
+	Batches                         []*OutgoingTxBatch           `protobuf:"bytes,5,rep,name=batches,proto3" json:"batches,omitempty"`
+	BatchConfirms                   []MsgConfirmBatch            `protobuf:"bytes,6,rep,name=batch_confirms,json=batchConfirms,proto3" json:"batch_confirms"`
+	LogicCalls                      []*OutgoingLogicCall         `protobuf:"bytes,7,rep,name=logic_calls,json=logicCalls,proto3" json:"logic_calls,omitempty"`
+	LogicCallConfirms               []MsgConfirmLogicCall        `protobuf:"bytes,8,rep,name=logic_call_confirms,json=logicCallConfirms,proto3" json:"logic_call_confirms"`
+	Attestations                    []Attestation                `protobuf:"bytes,9,rep,name=attestations,proto3" json:"attestations"`
+	DelegateKeys                    []*MsgSetOrchestratorAddress `protobuf:"bytes,10,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys,omitempty"`
+	Erc20ToDenoms                   []*ERC20ToDenom              `protobuf:"bytes,11,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms,omitempty"`
+	UnbatchedTransfers              []*OutgoingTransferTx        `protobuf:"bytes,12,rep,name=unbatched_transfers,json=unbatchedTransfers,proto3" json:"unbatched_transfers,omitempty"`
+	SuspendedTokens                 []string                     `protobuf:"bytes,13,rep,name=suspended_tokens,json=suspendedTokens,proto3" json:"suspended_tokens,omitempty"`
+	LastObservedEthereumHeightNonce uint64                       `protobuf:"varint,14,opt,name=last_observed_ethereum_height_nonce,json=lastObservedEthereumHeightNonce,proto3" json:"last_observed_ethereum_height_nonce,omitempty"`
+	BadSignatureEvidence            []BadSignatureEvidence       `protobuf:"bytes,15,rep,name=bad_signature_evidence,json=badSignatureEvidence,proto3" json:"bad_signature_evidence"`
+	ObservedEventRecords            []ObservedEventRecord        `protobuf:"bytes,16,rep,name=observed_event_records,json=observedEventRecords,proto3" json:"observed_event_records"`
+	PendingDepositForwards          []PendingDepositForward      `protobuf:"bytes,17,rep,name=pending_deposit_forwards,json=pendingDepositForwards,proto3" json:"pending_deposit_forwards"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -314,6 +477,41 @@ func (m *GenesisState) GetUnbatchedTransfers() []*OutgoingTransferTx {
 	return nil
 }
 
+func (m *GenesisState) GetSuspendedTokens() []string {
+	if m != nil {
+		return m.SuspendedTokens
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastObservedEthereumHeightNonce() uint64 {
+	if m != nil {
+		return m.LastObservedEthereumHeightNonce
+	}
+	return 0
+}
+
+func (m *GenesisState) GetBadSignatureEvidence() []BadSignatureEvidence {
+	if m != nil {
+		return m.BadSignatureEvidence
+	}
+	return nil
+}
+
+func (m *GenesisState) GetObservedEventRecords() []ObservedEventRecord {
+	if m != nil {
+		return m.ObservedEventRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingDepositForwards() []PendingDepositForward {
+	if m != nil {
+		return m.PendingDepositForwards
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "gravity.v1.Params")
 	proto.RegisterType((*GenesisState)(nil), "gravity.v1.GenesisState")
@@ -322,70 +520,100 @@ func init() {
 func init() { proto.RegisterFile("gravity/v1/genesis.proto", fileDescriptor_387b0aba880adb60) }
 
 var fileDescriptor_387b0aba880adb60 = []byte{
-	// 993 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0xdf, 0x4e, 0x23, 0x37,
-	0x14, 0xc6, 0x93, 0x6e, 0x36, 0x80, 0x93, 0xc0, 0xe2, 0x00, 0x6b, 0xfe, 0x6c, 0x88, 0x56, 0xea,
-	0x0a, 0x55, 0xcb, 0x0c, 0xa4, 0x6a, 0xa5, 0x56, 0x6a, 0x55, 0x12, 0x68, 0x77, 0xdb, 0x6e, 0xa9,
-	0x26, 0xb4, 0x95, 0xaa, 0x4a, 0xae, 0x67, 0xc6, 0xcc, 0x8c, 0x98, 0xd8, 0xc8, 0x76, 0x02, 0xdc,
-	0xf5, 0x11, 0x7a, 0xdb, 0x07, 0xe9, 0x3b, 0xec, 0xe5, 0x5e, 0x56, 0x55, 0xb5, 0xaa, 0xe0, 0x45,
-	0x56, 0x63, 0x7b, 0x26, 0x43, 0x96, 0x2b, 0xae, 0x60, 0xfc, 0x7d, 0xbf, 0x73, 0x4e, 0x8e, 0xed,
-	0x63, 0x80, 0x22, 0x41, 0x26, 0x89, 0xba, 0x72, 0x27, 0xfb, 0x6e, 0x44, 0x19, 0x95, 0x89, 0x74,
-	0xce, 0x05, 0x57, 0x1c, 0x02, 0xab, 0x38, 0x93, 0xfd, 0x8d, 0x95, 0x88, 0x47, 0x5c, 0x2f, 0xbb,
-	0xd9, 0x7f, 0xc6, 0xb1, 0xb1, 0x56, 0x62, 0xd5, 0xd5, 0x39, 0xb5, 0xe4, 0xc6, 0x6a, 0x69, 0x7d,
-	0x24, 0x23, 0x79, 0x87, 0xdd, 0x27, 0x2a, 0x88, 0xed, 0xfa, 0x56, 0x69, 0x9d, 0x28, 0x45, 0xa5,
-	0x22, 0x2a, 0xe1, 0xcc, 0xaa, 0x9d, 0x80, 0xcb, 0x11, 0x97, 0xae, 0x4f, 0x24, 0x75, 0x27, 0xfb,
-	0x3e, 0x55, 0x64, 0xdf, 0x0d, 0x78, 0x62, 0xf5, 0xa7, 0x7f, 0xcf, 0x83, 0xfa, 0x8f, 0x44, 0x90,
-	0x91, 0x84, 0x4f, 0x40, 0x5e, 0x33, 0x4e, 0x42, 0x54, 0xed, 0x56, 0x77, 0x16, 0xbc, 0x05, 0xbb,
-	0xf2, 0x32, 0x84, 0x7b, 0x60, 0x25, 0xe0, 0x4c, 0x09, 0x12, 0x28, 0x2c, 0xf9, 0x58, 0x04, 0x14,
-	0xc7, 0x44, 0xc6, 0xe8, 0x03, 0x6d, 0x84, 0xb9, 0x36, 0xd4, 0xd2, 0x0b, 0x22, 0x63, 0xf8, 0x29,
-	0x78, 0xec, 0x8b, 0x24, 0x8c, 0x28, 0xa6, 0x2a, 0xa6, 0x82, 0x8e, 0x47, 0x98, 0x84, 0xa1, 0xa0,
-	0x52, 0xa2, 0x9a, 0x86, 0x56, 0x8d, 0x7c, 0x64, 0xd5, 0x03, 0x23, 0xc2, 0x67, 0x60, 0xc9, 0x72,
-	0x41, 0x4c, 0x12, 0x96, 0x55, 0xf3, 0xb0, 0x5b, 0xdd, 0xa9, 0x79, 0x2d, 0xb3, 0x3c, 0xc8, 0x56,
-	0x5f, 0x86, 0xb0, 0x07, 0x56, 0x65, 0x12, 0x31, 0x1a, 0xe2, 0x09, 0x49, 0x25, 0x55, 0x12, 0x5f,
-	0x24, 0x2c, 0xe4, 0x17, 0xa8, 0xae, 0xdd, 0x6d, 0x23, 0xfe, 0x6c, 0xb4, 0x5f, 0xb4, 0x54, 0x62,
-	0x74, 0x0f, 0x69, 0xc1, 0xcc, 0x95, 0x99, 0xbe, 0xd1, 0x2c, 0xf3, 0x19, 0x58, 0xb7, 0x4c, 0xca,
-	0xa3, 0x24, 0xc0, 0x01, 0x49, 0xd3, 0x82, 0x9b, 0xd7, 0xdc, 0x9a, 0x31, 0x7c, 0x9f, 0xe9, 0x83,
-	0x4c, 0xb6, 0xe8, 0x1e, 0x58, 0x51, 0x44, 0x44, 0x54, 0x99, 0x74, 0x58, 0x25, 0x23, 0xca, 0xc7,
-	0x0a, 0x2d, 0x68, 0x0a, 0x1a, 0x4d, 0x67, 0x3b, 0x31, 0x0a, 0x7c, 0x0e, 0x20, 0x99, 0x50, 0x41,
-	0x22, 0x8a, 0xfd, 0x94, 0x07, 0x67, 0x1a, 0x41, 0x40, 0xfb, 0x1f, 0x59, 0xa5, 0x9f, 0x09, 0x19,
-	0x00, 0xbf, 0x00, 0x9b, 0xb9, 0xbb, 0xe8, 0x71, 0x09, 0x6b, 0x68, 0x0c, 0x59, 0x4b, 0xde, 0xe7,
-	0x29, 0xee, 0x83, 0x55, 0x99, 0x12, 0x19, 0xe3, 0xd3, 0x6c, 0xeb, 0x12, 0xce, 0x6c, 0x27, 0x51,
-	0xb3, 0x5b, 0xdd, 0x69, 0xf6, 0x9d, 0xd7, 0x6f, 0xb7, 0x2b, 0xff, 0xbe, 0xdd, 0x7e, 0x16, 0x25,
-	0x2a, 0x1e, 0xfb, 0x4e, 0xc0, 0x47, 0xae, 0x3d, 0x4f, 0xe6, 0xcf, 0xae, 0x0c, 0xcf, 0xec, 0xd9,
-	0x3d, 0xa4, 0x81, 0xd7, 0xd6, 0xc1, 0xbe, 0xb6, 0xb1, 0x4c, 0xe3, 0xe1, 0xef, 0x60, 0x65, 0x26,
-	0x87, 0x6e, 0x05, 0x6a, 0xdd, 0x2b, 0x05, 0xbc, 0x95, 0x42, 0x77, 0x0e, 0x26, 0x60, 0x7d, 0x26,
-	0xc3, 0x74, 0x9f, 0xd0, 0xe2, 0xbd, 0xd2, 0xac, 0xdd, 0x4a, 0x53, 0x6c, 0x2b, 0x1c, 0x80, 0xce,
-	0x98, 0xf9, 0x9c, 0x85, 0x58, 0x1b, 0x12, 0x16, 0xcd, 0x9e, 0xbd, 0x25, 0xdd, 0xf2, 0x4d, 0xe3,
-	0x1a, 0x5a, 0xd3, 0xed, 0x33, 0x38, 0x01, 0xdd, 0xf7, 0x3a, 0x12, 0x66, 0xfb, 0x87, 0xb3, 0x53,
-	0x44, 0xd4, 0x58, 0x50, 0xf4, 0xe8, 0x5e, 0x65, 0x6f, 0xcd, 0x74, 0x27, 0x3c, 0x52, 0xf1, 0x30,
-	0x8f, 0x09, 0x0f, 0x41, 0xcb, 0x14, 0x8b, 0x05, 0xbd, 0x20, 0x22, 0x44, 0xcb, 0xdd, 0xea, 0x4e,
-	0xa3, 0xb7, 0xee, 0x98, 0x58, 0x4e, 0x36, 0x23, 0x1c, 0x3b, 0x23, 0x9c, 0x01, 0x4f, 0x58, 0xbf,
-	0x96, 0xe5, 0xf7, 0x9a, 0x86, 0xf2, 0x34, 0xf4, 0x79, 0xed, 0x8f, 0xff, 0xba, 0x95, 0xa7, 0x7f,
-	0xd5, 0x41, 0xf3, 0x1b, 0x33, 0xf0, 0x86, 0x8a, 0x28, 0x0a, 0x3f, 0x02, 0xf5, 0x73, 0x3d, 0x47,
-	0xf4, 0xe4, 0x68, 0xf4, 0xa0, 0x33, 0x1d, 0x80, 0x8e, 0x99, 0x30, 0x9e, 0x75, 0x40, 0x07, 0xb4,
-	0x53, 0x22, 0x15, 0xe6, 0xbe, 0xa4, 0x62, 0x42, 0x43, 0xcc, 0x38, 0x0b, 0xa8, 0x9e, 0x24, 0x35,
-	0x6f, 0x39, 0x93, 0x8e, 0xad, 0xf2, 0x43, 0x26, 0xc0, 0xe7, 0x60, 0xce, 0x76, 0x19, 0x3d, 0xe8,
-	0x3e, 0x98, 0x0d, 0x6e, 0x9a, 0xeb, 0xe5, 0x16, 0x78, 0x04, 0x96, 0xec, 0xcf, 0x0c, 0x38, 0x3b,
-	0x4d, 0xc4, 0x28, 0x1b, 0x37, 0x19, 0xb5, 0x55, 0xa6, 0x5e, 0x49, 0xbb, 0x2b, 0x03, 0x63, 0xf2,
-	0x16, 0x27, 0xe5, 0x4f, 0x09, 0x3f, 0x01, 0x73, 0x76, 0x44, 0xa0, 0x87, 0x1a, 0xdf, 0x2c, 0xe3,
-	0xc7, 0x63, 0x15, 0xf1, 0x84, 0x45, 0x27, 0x97, 0xfa, 0x0c, 0x7a, 0xb9, 0x17, 0xbe, 0x00, 0x8b,
-	0xe6, 0xaa, 0x17, 0xc9, 0xeb, 0xef, 0xd3, 0xaf, 0x64, 0x64, 0xf3, 0x68, 0xda, 0xf6, 0xb9, 0xa5,
-	0xc1, 0xa2, 0x80, 0x2f, 0x41, 0xa3, 0x34, 0x6f, 0xd0, 0x9c, 0x0e, 0xf3, 0xe4, 0xae, 0x22, 0x8a,
-	0xf3, 0xe9, 0x81, 0xb4, 0x98, 0x40, 0xf0, 0x27, 0xd0, 0x9e, 0xf2, 0xd3, 0x72, 0xe6, 0x75, 0x9c,
-	0xed, 0xbb, 0xcb, 0x29, 0x22, 0xd9, 0x92, 0x96, 0x8b, 0x78, 0x45, 0x59, 0x07, 0xa0, 0x59, 0x7a,
-	0x66, 0x24, 0x5a, 0xd0, 0xf1, 0x1e, 0x97, 0xe3, 0x1d, 0x4c, 0xf5, 0xfc, 0x08, 0x95, 0x11, 0xf8,
-	0x2d, 0x68, 0x85, 0x34, 0xa5, 0x11, 0x51, 0x14, 0x9f, 0xd1, 0x2b, 0x89, 0x80, 0x8e, 0xf1, 0xe1,
-	0x4c, 0x4d, 0x43, 0xaa, 0x8e, 0x45, 0xd6, 0x54, 0x25, 0x88, 0xe2, 0xc2, 0x3e, 0x0f, 0x5e, 0x33,
-	0x67, 0xbf, 0xa3, 0x57, 0x12, 0x7e, 0x05, 0x96, 0xa8, 0x08, 0x7a, 0x7b, 0x58, 0x71, 0x1c, 0x52,
-	0xc6, 0x47, 0x12, 0x35, 0x74, 0x34, 0x54, 0x8e, 0x76, 0xe4, 0x0d, 0x7a, 0x7b, 0x27, 0xfc, 0x30,
-	0x33, 0x78, 0x2d, 0x0d, 0xd8, 0x2f, 0x09, 0x8f, 0x41, 0x7b, 0xcc, 0xcc, 0xf6, 0x85, 0x58, 0x09,
-	0xc2, 0xe4, 0x29, 0x15, 0x12, 0x35, 0x75, 0x94, 0xce, 0x9d, 0x9b, 0x6e, 0x4d, 0x27, 0x97, 0x1e,
-	0x2c, 0xd0, 0x7c, 0x51, 0xf6, 0x7f, 0x7b, 0x7d, 0xdd, 0xa9, 0xbe, 0xb9, 0xee, 0x54, 0xff, 0xbf,
-	0xee, 0x54, 0xff, 0xbc, 0xe9, 0x54, 0xde, 0xdc, 0x74, 0x2a, 0xff, 0xdc, 0x74, 0x2a, 0xbf, 0xf6,
-	0x4b, 0xf7, 0x98, 0xa4, 0x2a, 0xa6, 0x64, 0x97, 0x51, 0x95, 0xdf, 0x65, 0x9b, 0x69, 0xd7, 0xbc,
-	0x72, 0xee, 0x88, 0x87, 0xe3, 0x94, 0xba, 0x97, 0x6e, 0xfe, 0xc0, 0xeb, 0x7b, 0xee, 0xd7, 0xf5,
-	0xc3, 0xfd, 0xf1, 0xbb, 0x00, 0x00, 0x00, 0xff, 0xff, 0x70, 0x7b, 0x92, 0x4a, 0x7b, 0x08, 0x00,
-	0x00,
+	// 1474 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xdd, 0x52, 0x1b, 0xc7,
+	0x12, 0x46, 0xc7, 0x18, 0xcc, 0x20, 0x10, 0x0c, 0x7f, 0xc3, 0x9f, 0xd0, 0xf1, 0x39, 0xc7, 0x87,
+	0x24, 0xb6, 0x04, 0xa4, 0x92, 0x54, 0x52, 0x15, 0x27, 0x08, 0xf0, 0x4f, 0x6c, 0x02, 0xb5, 0x10,
+	0x27, 0x95, 0xb8, 0x6a, 0x32, 0xda, 0x6d, 0x56, 0x1b, 0xb4, 0x3b, 0xca, 0xcc, 0x48, 0x88, 0xbb,
+	0x3c, 0x42, 0x6e, 0xf2, 0x1e, 0x79, 0x0c, 0x5f, 0xfa, 0x32, 0x95, 0x4a, 0xb9, 0x52, 0xf6, 0x8b,
+	0xa4, 0xe6, 0x67, 0x57, 0x2b, 0xd0, 0x15, 0x57, 0xac, 0xfa, 0xfb, 0xbe, 0xee, 0xde, 0x9e, 0x9e,
+	0xee, 0x05, 0x91, 0x50, 0xb0, 0x6e, 0xa4, 0x2e, 0x6b, 0xdd, 0xed, 0x5a, 0x08, 0x09, 0xc8, 0x48,
+	0x56, 0xdb, 0x82, 0x2b, 0x8e, 0x91, 0x43, 0xaa, 0xdd, 0xed, 0x95, 0xf9, 0x90, 0x87, 0xdc, 0x98,
+	0x6b, 0xfa, 0xc9, 0x32, 0x56, 0x16, 0x73, 0x5a, 0x75, 0xd9, 0x06, 0xa7, 0x5c, 0x59, 0xc8, 0xd9,
+	0x63, 0x19, 0xca, 0x21, 0xf4, 0x06, 0x53, 0x7e, 0xd3, 0xd9, 0xd7, 0x72, 0x76, 0xa6, 0x14, 0x48,
+	0xc5, 0x54, 0xc4, 0x13, 0x87, 0x96, 0x7d, 0x2e, 0x63, 0x2e, 0x6b, 0x0d, 0x26, 0xa1, 0xd6, 0xdd,
+	0x6e, 0x80, 0x62, 0xdb, 0x35, 0x9f, 0x47, 0x0e, 0xbf, 0xfb, 0x5b, 0x09, 0x8d, 0x1d, 0x33, 0xc1,
+	0x62, 0x89, 0xd7, 0x51, 0x9a, 0x33, 0x8d, 0x02, 0x52, 0xa8, 0x14, 0x36, 0x27, 0xbc, 0x09, 0x67,
+	0x79, 0x1a, 0xe0, 0x2d, 0x34, 0xef, 0xf3, 0x44, 0x09, 0xe6, 0x2b, 0x2a, 0x79, 0x47, 0xf8, 0x40,
+	0x9b, 0x4c, 0x36, 0xc9, 0xbf, 0x0c, 0x11, 0xa7, 0xd8, 0x89, 0x81, 0x9e, 0x30, 0xd9, 0xc4, 0x1f,
+	0xa3, 0xa5, 0x86, 0x88, 0x82, 0x10, 0x28, 0xa8, 0x26, 0x08, 0xe8, 0xc4, 0x94, 0x05, 0x81, 0x00,
+	0x29, 0xc9, 0xa8, 0x11, 0x2d, 0x58, 0xf8, 0xc0, 0xa1, 0xbb, 0x16, 0xc4, 0xf7, 0x50, 0xc9, 0xe9,
+	0xfc, 0x26, 0x8b, 0x12, 0x9d, 0xcd, 0xed, 0x4a, 0x61, 0x73, 0xd4, 0x9b, 0xb2, 0xe6, 0x3d, 0x6d,
+	0x7d, 0x1a, 0xe0, 0x1d, 0xb4, 0x20, 0xa3, 0x30, 0x81, 0x80, 0x76, 0x59, 0x4b, 0x82, 0x92, 0xf4,
+	0x22, 0x4a, 0x02, 0x7e, 0x41, 0xc6, 0x0c, 0x7b, 0xce, 0x82, 0x2f, 0x2c, 0xf6, 0xad, 0x81, 0x72,
+	0x1a, 0x53, 0x43, 0xc8, 0x34, 0xe3, 0x79, 0x4d, 0xdd, 0x62, 0x4e, 0xf3, 0x29, 0x5a, 0x76, 0x9a,
+	0x16, 0x0f, 0x23, 0x9f, 0xfa, 0xac, 0xd5, 0xca, 0x74, 0x77, 0x8c, 0x6e, 0xd1, 0x12, 0x9e, 0x6b,
+	0x7c, 0x4f, 0xc3, 0x4e, 0xba, 0x85, 0xe6, 0x15, 0x13, 0x21, 0x28, 0x1b, 0x8e, 0xaa, 0x28, 0x06,
+	0xde, 0x51, 0x64, 0xc2, 0xa8, 0xb0, 0xc5, 0x4c, 0xb4, 0x53, 0x8b, 0xe0, 0xfb, 0x08, 0xb3, 0x2e,
+	0x08, 0x16, 0x02, 0x6d, 0xb4, 0xb8, 0x7f, 0x6e, 0x24, 0x04, 0x19, 0xfe, 0x8c, 0x43, 0xea, 0x1a,
+	0xd0, 0x02, 0xfc, 0x39, 0x5a, 0x4d, 0xd9, 0x59, 0x8d, 0x73, 0xb2, 0x49, 0x23, 0x23, 0x8e, 0x92,
+	0xd6, 0xb9, 0x2f, 0x6f, 0xa0, 0x05, 0xd9, 0x62, 0xb2, 0x49, 0xcf, 0xf4, 0xd1, 0x45, 0x3c, 0x71,
+	0x95, 0x24, 0xc5, 0x4a, 0x61, 0xb3, 0x58, 0xaf, 0xbe, 0x7a, 0xb3, 0x31, 0xf2, 0xe7, 0x9b, 0x8d,
+	0x7b, 0x61, 0xa4, 0x9a, 0x9d, 0x46, 0xd5, 0xe7, 0x71, 0xcd, 0xf5, 0x93, 0xfd, 0xf3, 0x40, 0x06,
+	0xe7, 0xae, 0x77, 0xf7, 0xc1, 0xf7, 0xe6, 0x8c, 0xb3, 0x47, 0xce, 0x97, 0x2d, 0x3c, 0xfe, 0x11,
+	0xcd, 0x5f, 0x89, 0x61, 0x4a, 0x41, 0xa6, 0x6e, 0x14, 0x02, 0x0f, 0x84, 0x30, 0x95, 0xc3, 0x11,
+	0x5a, 0xbe, 0x12, 0xa1, 0x7f, 0x4e, 0x64, 0xfa, 0x46, 0x61, 0x16, 0x07, 0xc2, 0x64, 0xc7, 0x8a,
+	0xf7, 0x50, 0xb9, 0x93, 0x34, 0x78, 0x12, 0x50, 0x43, 0x88, 0x92, 0xf0, 0x6a, 0xef, 0x95, 0x4c,
+	0xc9, 0x57, 0x2d, 0xeb, 0xc4, 0x91, 0x06, 0x7b, 0xb0, 0x8b, 0x2a, 0xd7, 0x2a, 0x12, 0xe8, 0xf3,
+	0xa3, 0xba, 0x8b, 0x98, 0xea, 0x08, 0x20, 0x33, 0x37, 0x4a, 0x7b, 0xed, 0x4a, 0x75, 0x82, 0x03,
+	0xd5, 0x3c, 0x49, 0x7d, 0xe2, 0x7d, 0x34, 0x65, 0x93, 0xa5, 0x02, 0x2e, 0x98, 0x08, 0xc8, 0x6c,
+	0xa5, 0xb0, 0x39, 0xb9, 0xb3, 0x5c, 0xb5, 0xbe, 0xaa, 0x7a, 0x46, 0x54, 0xdd, 0x8c, 0xa8, 0xee,
+	0xf1, 0x28, 0xa9, 0x8f, 0xea, 0xf8, 0x5e, 0xd1, 0xaa, 0x3c, 0x23, 0xc2, 0xcf, 0xd0, 0xdd, 0x81,
+	0x5e, 0xa6, 0xb2, 0x23, 0xdb, 0x90, 0x48, 0xfd, 0x1e, 0xaa, 0x29, 0x40, 0x36, 0x79, 0x2b, 0x20,
+	0xd8, 0x94, 0x61, 0xa3, 0x91, 0x6b, 0xed, 0x93, 0x8c, 0x77, 0x9a, 0xd2, 0xf0, 0x43, 0xb4, 0x16,
+	0xb3, 0x5e, 0xbf, 0x98, 0x91, 0x82, 0x58, 0xd2, 0x36, 0x08, 0xdb, 0xc5, 0x64, 0xce, 0x36, 0x70,
+	0xcc, 0x7a, 0x69, 0x29, 0x9f, 0x6a, 0xc6, 0x31, 0x08, 0xd3, 0xc4, 0xf8, 0xff, 0xa8, 0xe4, 0xf3,
+	0xe4, 0x2c, 0x12, 0x71, 0x76, 0x00, 0xf3, 0x46, 0x32, 0x9d, 0x9a, 0x5d, 0xcd, 0x01, 0x2d, 0xc5,
+	0x51, 0x42, 0x33, 0xb2, 0x0e, 0xe1, 0x04, 0x0b, 0x37, 0x2a, 0xf5, 0x7c, 0x1c, 0x25, 0x7b, 0xce,
+	0xdb, 0x31, 0x08, 0x17, 0xe6, 0x13, 0x44, 0x7e, 0x62, 0x51, 0x8b, 0x9e, 0x71, 0x41, 0xe3, 0x48,
+	0x4a, 0x08, 0xb2, 0x90, 0x64, 0xb1, 0x52, 0xd8, 0xbc, 0xe3, 0x2d, 0x68, 0xfc, 0x11, 0x17, 0x87,
+	0x06, 0x4d, 0x3d, 0xe0, 0x9f, 0xd1, 0xba, 0x6e, 0x82, 0xac, 0x01, 0x28, 0x74, 0xa3, 0x00, 0x12,
+	0x1f, 0x68, 0x83, 0x77, 0x12, 0x75, 0x49, 0x96, 0x6e, 0x94, 0xe5, 0x4a, 0x83, 0x05, 0x59, 0x03,
+	0x1c, 0x38, 0x97, 0x75, 0xe3, 0x11, 0xef, 0xa2, 0xf5, 0x73, 0xb8, 0xa4, 0x02, 0xc2, 0x48, 0x2a,
+	0x61, 0x96, 0x06, 0x0d, 0x05, 0xf3, 0x41, 0x17, 0x27, 0xe2, 0x01, 0x21, 0xa6, 0x92, 0x2b, 0xe7,
+	0x70, 0xe9, 0xe5, 0x38, 0x8f, 0x35, 0xe5, 0xd8, 0x30, 0xf4, 0x34, 0xd5, 0xc7, 0xe7, 0xa6, 0x75,
+	0x97, 0xb5, 0xa2, 0x80, 0x29, 0x2e, 0x24, 0x59, 0xb6, 0xd3, 0x34, 0x66, 0xbd, 0xba, 0xc1, 0x5e,
+	0x64, 0x10, 0xfe, 0x0e, 0xcd, 0xe8, 0x93, 0x70, 0x9a, 0x36, 0xbf, 0x00, 0x41, 0x56, 0x6e, 0xf4,
+	0x72, 0xd3, 0x71, 0x94, 0x58, 0xf7, 0xc7, 0xda, 0x0b, 0xfe, 0x2f, 0x9a, 0xd6, 0xd9, 0xb8, 0x1e,
+	0x67, 0x21, 0x90, 0x55, 0x93, 0x46, 0x31, 0x66, 0x3d, 0x7b, 0x03, 0x77, 0x43, 0xd0, 0x23, 0x39,
+	0xb7, 0x26, 0x25, 0x55, 0x9c, 0x9e, 0x03, 0xb4, 0xc9, 0x9a, 0x1d, 0xc9, 0x79, 0xec, 0x94, 0x3f,
+	0x03, 0x68, 0xe3, 0x2f, 0xd0, 0xda, 0x19, 0x00, 0x85, 0x1e, 0xc4, 0x6d, 0x45, 0xb9, 0xd0, 0xab,
+	0x41, 0x17, 0x43, 0x9f, 0xb1, 0x0c, 0x25, 0x59, 0x37, 0xca, 0xe5, 0x33, 0x80, 0x03, 0x43, 0x39,
+	0xca, 0x31, 0x0e, 0x65, 0x28, 0xf1, 0x07, 0x08, 0xe7, 0x1c, 0xe8, 0x1c, 0x43, 0x26, 0x49, 0xd9,
+	0xc8, 0x4a, 0x99, 0xec, 0x90, 0xf5, 0x1e, 0x33, 0xf9, 0xd9, 0xe8, 0x2f, 0x7f, 0x55, 0x46, 0xee,
+	0xfe, 0x3e, 0x81, 0x8a, 0x8f, 0xed, 0x07, 0xc5, 0x89, 0x62, 0x0a, 0xf0, 0xfb, 0x68, 0xac, 0x6d,
+	0xf6, 0xb4, 0xd9, 0xcc, 0x93, 0x3b, 0xb8, 0xda, 0xff, 0xc0, 0xa8, 0xda, 0x0d, 0xee, 0x39, 0x06,
+	0xae, 0xa2, 0xb9, 0x16, 0x93, 0x8a, 0xf2, 0x86, 0x04, 0xd1, 0x85, 0x80, 0x26, 0x3c, 0xf1, 0xc1,
+	0x6c, 0xea, 0x51, 0x6f, 0x56, 0x43, 0x47, 0x0e, 0xf9, 0x5a, 0x03, 0xf8, 0x3e, 0x1a, 0x77, 0x53,
+	0x8c, 0xdc, 0xaa, 0xdc, 0xba, 0xea, 0xdc, 0x96, 0xce, 0x4b, 0x29, 0xf8, 0x00, 0x95, 0xec, 0x63,
+	0xbf, 0xb5, 0x47, 0x8d, 0x6a, 0x2d, 0xaf, 0x3a, 0x94, 0x6e, 0xea, 0xb9, 0x16, 0xf7, 0xa6, 0xbb,
+	0xf9, 0x9f, 0x12, 0x7f, 0x84, 0xc6, 0xdd, 0x0a, 0x26, 0xb7, 0x8d, 0x7c, 0x35, 0x2f, 0x3f, 0xea,
+	0xa8, 0x90, 0x47, 0x49, 0x78, 0xda, 0x33, 0x33, 0xde, 0x4b, 0xb9, 0xf8, 0x09, 0x9a, 0x36, 0x8f,
+	0xfd, 0xe0, 0x63, 0xd7, 0xd5, 0x87, 0x32, 0x74, 0x71, 0x8c, 0xda, 0xcd, 0xb1, 0x29, 0x23, 0xcc,
+	0x12, 0x78, 0x88, 0x26, 0x73, 0xfb, 0x9c, 0x8c, 0x1b, 0x37, 0xeb, 0xc3, 0x92, 0xc8, 0xe6, 0xbf,
+	0x87, 0x5a, 0xe9, 0xa3, 0xc4, 0xdf, 0xa0, 0xb9, 0xbe, 0xbe, 0x9f, 0xce, 0x1d, 0xe3, 0x67, 0x63,
+	0x78, 0x3a, 0x99, 0x27, 0x97, 0xd2, 0x6c, 0xe6, 0x2f, 0x4b, 0x6b, 0x17, 0x15, 0xf3, 0x3d, 0x48,
+	0x26, 0x8c, 0xbf, 0xa5, 0xbc, 0xbf, 0xdd, 0x3e, 0x9e, 0x8e, 0xe8, 0xbc, 0x04, 0x7f, 0x85, 0xa6,
+	0x02, 0x68, 0x41, 0xc8, 0x14, 0xd0, 0x73, 0xb8, 0x94, 0x04, 0x19, 0x1f, 0xff, 0xbb, 0x92, 0xd3,
+	0x09, 0x0c, 0xb4, 0xaa, 0xfb, 0xfc, 0xf2, 0x8a, 0xa9, 0xf6, 0x19, 0x5c, 0x4a, 0xfc, 0x25, 0x2a,
+	0x81, 0xf0, 0x77, 0xb6, 0xf4, 0x3d, 0x09, 0x20, 0xe1, 0xb1, 0x24, 0x93, 0xc6, 0x1b, 0xc9, 0x7b,
+	0x3b, 0xf0, 0xf6, 0x76, 0xb6, 0x4e, 0xf9, 0xbe, 0x26, 0x78, 0x53, 0x46, 0xe0, 0x7e, 0x49, 0x7c,
+	0x84, 0xe6, 0x3a, 0x89, 0x3d, 0xbe, 0x80, 0x2a, 0xc1, 0x12, 0x79, 0x06, 0x42, 0x92, 0xa2, 0xf1,
+	0x52, 0x1e, 0x7a, 0xe8, 0x8e, 0x74, 0xda, 0xf3, 0x70, 0x26, 0x4d, 0x8d, 0x12, 0xbf, 0x87, 0x66,
+	0xec, 0xce, 0x09, 0xb4, 0x43, 0x7e, 0x0e, 0x89, 0x24, 0x53, 0x95, 0x5b, 0x9b, 0x13, 0x5e, 0x29,
+	0xb3, 0x9f, 0x1a, 0x33, 0x7e, 0x8e, 0xfe, 0x33, 0x78, 0x13, 0xb2, 0xaf, 0xa4, 0x26, 0x44, 0x61,
+	0x53, 0xb9, 0x9b, 0x31, 0x6d, 0xb7, 0x55, 0xfe, 0x66, 0xa4, 0x1f, 0x4b, 0x4f, 0x0c, 0xcf, 0xde,
+	0x93, 0x97, 0x68, 0x71, 0xf8, 0x90, 0x26, 0x25, 0xf3, 0x32, 0x95, 0xfc, 0xcb, 0xd4, 0x87, 0x4d,
+	0x5e, 0x7b, 0x5a, 0xf3, 0xc3, 0xa6, 0x32, 0xfe, 0x01, 0x2d, 0xf6, 0xd3, 0xec, 0x42, 0xa2, 0xd7,
+	0xb4, 0xcf, 0x45, 0x20, 0xc9, 0xcc, 0xf5, 0x96, 0xca, 0xd2, 0xd4, 0x44, 0xcf, 0xf0, 0x52, 0xe7,
+	0xfc, 0x3a, 0x24, 0x31, 0x43, 0x44, 0x17, 0x46, 0xef, 0xd8, 0x00, 0xda, 0x5c, 0x46, 0x4a, 0xef,
+	0x28, 0xbd, 0xd0, 0x25, 0x99, 0x35, 0xee, 0xff, 0x3d, 0x30, 0x50, 0x2c, 0x77, 0xdf, 0x52, 0x1f,
+	0x59, 0xa6, 0x0b, 0xb0, 0xd8, 0x1e, 0x06, 0xca, 0xfa, 0xcb, 0x57, 0x6f, 0xcb, 0x85, 0xd7, 0x6f,
+	0xcb, 0x85, 0xbf, 0xdf, 0x96, 0x0b, 0xbf, 0xbe, 0x2b, 0x8f, 0xbc, 0x7e, 0x57, 0x1e, 0xf9, 0xe3,
+	0x5d, 0x79, 0xe4, 0xfb, 0x7a, 0x6e, 0xa0, 0xb3, 0x96, 0x6a, 0x02, 0x7b, 0x90, 0x80, 0x4a, 0x87,
+	0xba, 0x0b, 0xfb, 0xc0, 0x6e, 0x84, 0x5a, 0xcc, 0x83, 0x4e, 0x0b, 0x6a, 0xbd, 0x9a, 0xb3, 0xdb,
+	0x81, 0xdf, 0x18, 0x33, 0xff, 0xaf, 0x7c, 0xf8, 0xcf, 0x00, 0x3f, 0xb9, 0xac, 0x19, 0x72, 0x0d,
+	0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -408,6 +636,117 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FeeExemptMaxGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.FeeExemptMaxGas))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	if m.FeeExemptOrchestratorMsgs != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.FeeExemptOrchestratorMsgs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	if m.AttestationsToKeep != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AttestationsToKeep))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.MaxValsetAge != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxValsetAge))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	{
+		size := m.MinBridgePower.Size()
+		i -= size
+		if _, err := m.MinBridgePower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xd2
+	if m.MaxBridgeValidators != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxBridgeValidators))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.KeyRegistrationGracePeriod != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.KeyRegistrationGracePeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	{
+		size := m.BadSignatureEvidenceBounty.Size()
+		i -= size
+		if _, err := m.BadSignatureEvidenceBounty.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	if m.JailForMissedConfirms {
+		i--
+		if m.JailForMissedConfirms {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	{
+		size := m.MinConfirmsPerWindow.Size()
+		i -= size
+		if _, err := m.MinConfirmsPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if m.ConfirmsWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ConfirmsWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.MaxSlashingItemsPerBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxSlashingItemsPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.BatchTimeoutSuspensionThreshold != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BatchTimeoutSuspensionThreshold))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
 	{
 		size, err := m.ValsetReward.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -546,6 +885,66 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingDepositForwards) > 0 {
+		for iNdEx := len(m.PendingDepositForwards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingDepositForwards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.ObservedEventRecords) > 0 {
+		for iNdEx := len(m.ObservedEventRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ObservedEventRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.BadSignatureEvidence) > 0 {
+		for iNdEx := len(m.BadSignatureEvidence) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BadSignatureEvidence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.LastObservedEthereumHeightNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastObservedEthereumHeightNonce))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.SuspendedTokens) > 0 {
+		for iNdEx := len(m.SuspendedTokens) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SuspendedTokens[iNdEx])
+			copy(dAtA[i:], m.SuspendedTokens[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.SuspendedTokens[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	if len(m.UnbatchedTransfers) > 0 {
 		for iNdEx := len(m.UnbatchedTransfers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -769,6 +1168,42 @@ func (m *Params) Size() (n int) {
 	n += 2 + l + sovGenesis(uint64(l))
 	l = m.ValsetReward.Size()
 	n += 2 + l + sovGenesis(uint64(l))
+	if m.BatchTimeoutSuspensionThreshold != 0 {
+		n += 2 + sovGenesis(uint64(m.BatchTimeoutSuspensionThreshold))
+	}
+	if m.MaxSlashingItemsPerBlock != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxSlashingItemsPerBlock))
+	}
+	if m.ConfirmsWindow != 0 {
+		n += 2 + sovGenesis(uint64(m.ConfirmsWindow))
+	}
+	l = m.MinConfirmsPerWindow.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.JailForMissedConfirms {
+		n += 3
+	}
+	l = m.BadSignatureEvidenceBounty.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.KeyRegistrationGracePeriod != 0 {
+		n += 2 + sovGenesis(uint64(m.KeyRegistrationGracePeriod))
+	}
+	if m.MaxBridgeValidators != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxBridgeValidators))
+	}
+	l = m.MinBridgePower.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.MaxValsetAge != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxValsetAge))
+	}
+	if m.AttestationsToKeep != 0 {
+		n += 2 + sovGenesis(uint64(m.AttestationsToKeep))
+	}
+	if m.FeeExemptOrchestratorMsgs != 0 {
+		n += 2 + sovGenesis(uint64(m.FeeExemptOrchestratorMsgs))
+	}
+	if m.FeeExemptMaxGas != 0 {
+		n += 2 + sovGenesis(uint64(m.FeeExemptMaxGas))
+	}
 	return n
 }
 
@@ -845,6 +1280,33 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SuspendedTokens) > 0 {
+		for _, s := range m.SuspendedTokens {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastObservedEthereumHeightNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.LastObservedEthereumHeightNonce))
+	}
+	if len(m.BadSignatureEvidence) > 0 {
+		for _, e := range m.BadSignatureEvidence {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ObservedEventRecords) > 0 {
+		for _, e := range m.ObservedEventRecords {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingDepositForwards) > 0 {
+		for _, e := range m.PendingDepositForwards {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1296,20 +1758,310 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchTimeoutSuspensionThreshold", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenesis
+			m.BatchTimeoutSuspensionThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchTimeoutSuspensionThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenesis
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlashingItemsPerBlock", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.MaxSlashingItemsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSlashingItemsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmsWindow", wireType)
+			}
+			m.ConfirmsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfirmsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinConfirmsPerWindow", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinConfirmsPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailForMissedConfirms", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.JailForMissedConfirms = bool(v != 0)
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadSignatureEvidenceBounty", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BadSignatureEvidenceBounty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRegistrationGracePeriod", wireType)
+			}
+			m.KeyRegistrationGracePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyRegistrationGracePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBridgeValidators", wireType)
+			}
+			m.MaxBridgeValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBridgeValidators |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBridgePower", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBridgePower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValsetAge", wireType)
+			}
+			m.MaxValsetAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValsetAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationsToKeep", wireType)
+			}
+			m.AttestationsToKeep = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestationsToKeep |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeExemptOrchestratorMsgs", wireType)
+			}
+			m.FeeExemptOrchestratorMsgs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeExemptOrchestratorMsgs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeExemptMaxGas", wireType)
+			}
+			m.FeeExemptMaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeExemptMaxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -1744,6 +2496,159 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspendedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SuspendedTokens = append(m.SuspendedTokens, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEthereumHeightNonce", wireType)
+			}
+			m.LastObservedEthereumHeightNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastObservedEthereumHeightNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadSignatureEvidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BadSignatureEvidence = append(m.BadSignatureEvidence, BadSignatureEvidence{})
+			if err := m.BadSignatureEvidence[len(m.BadSignatureEvidence)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedEventRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObservedEventRecords = append(m.ObservedEventRecords, ObservedEventRecord{})
+			if err := m.ObservedEventRecords[len(m.ObservedEventRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDepositForwards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingDepositForwards = append(m.PendingDepositForwards, PendingDepositForward{})
+			if err := m.PendingDepositForwards[len(m.PendingDepositForwards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
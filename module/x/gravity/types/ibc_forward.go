@@ -0,0 +1,74 @@
+package types
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
+)
+
+// DefaultIBCForwardTimeout is how long a forwarded deposit has to be relayed when its receiver gives no timeout
+const DefaultIBCForwardTimeout = time.Hour
+
+// IBCForward is the IBC destination a deposit is forwarded to once it is credited
+type IBCForward struct {
+	// Channel is the transfer channel the deposit is sent over
+	Channel string
+	// Receiver is the address on the counterparty chain
+	Receiver string
+	// Timeout is how long the transfer has to be relayed, counted from the block the deposit is credited in
+	Timeout time.Duration
+}
+
+// ParseDepositReceiver parses the receiver of a deposit, either a local address or an IBC destination of the form
+//
+//	<channel-id>/<bech32 receiver>/<bech32 fallback>[/<timeout in seconds>]
+//
+// e.g. channel-0/osmo1.../gravity1.../600 to time out after ten minutes. It returns the local account the deposit
+// is credited to and for IBC destinations the forward to make. The local account of an IBC destination is its
+// fallback, an address on this chain given by the depositor, where the deposit ends up if the forward fails,
+// times out or is rejected by the counterparty. It can't be derived from the receiver as the counterparty may
+// derive its addresses from keys differently.
+func ParseDepositReceiver(receiver string) (sdk.AccAddress, *IBCForward, error) {
+	parts := strings.Split(receiver, "/")
+	if len(parts) == 1 {
+		addr, err := sdk.AccAddressFromBech32(receiver)
+		if err != nil {
+			return nil, nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, receiver)
+		}
+		return addr, nil, nil
+	}
+	if len(parts) < 3 || len(parts) > 4 {
+		return nil, nil, sdkerrors.Wrapf(ErrInvalid, "ibc destination %s must have 3 or 4 parts", receiver)
+	}
+
+	forward := IBCForward{
+		Channel:  parts[0],
+		Receiver: parts[1],
+		Timeout:  DefaultIBCForwardTimeout,
+	}
+	if err := host.ChannelIdentifierValidator(forward.Channel); err != nil {
+		return nil, nil, sdkerrors.Wrapf(err, "ibc destination %s", receiver)
+	}
+	// the counterparty's address format is unknown, only check that the receiver is bech32
+	if _, bz, err := bech32.DecodeAndConvert(forward.Receiver); err != nil || len(bz) == 0 {
+		return nil, nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "ibc destination %s receiver", receiver)
+	}
+	fallback, err := sdk.AccAddressFromBech32(parts[2])
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "ibc destination %s fallback: %s", receiver, err)
+	}
+	if len(parts) == 4 {
+		seconds, err := strconv.ParseUint(parts[3], 10, 32)
+		if err != nil || seconds == 0 {
+			return nil, nil, sdkerrors.Wrapf(ErrInvalid, "ibc destination %s timeout", receiver)
+		}
+		forward.Timeout = time.Duration(seconds) * time.Second
+	}
+
+	return fallback, &forward, nil
+}
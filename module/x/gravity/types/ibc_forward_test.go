@@ -0,0 +1,58 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDepositReceiver(t *testing.T) {
+	local := sdk.AccAddress(make([]byte, sdk.AddrLen))
+	local[0] = 1
+	fallback := sdk.AccAddress(make([]byte, sdk.AddrLen))
+	fallback[0] = 2
+	osmo, err := bech32.ConvertAndEncode("osmo", local)
+	require.NoError(t, err)
+	// counterparty addresses need not be the length of local ones
+	long, err := bech32.ConvertAndEncode("cosmos", make([]byte, 32))
+	require.NoError(t, err)
+
+	addr, forward, err := ParseDepositReceiver(local.String())
+	require.NoError(t, err)
+	assert.Equal(t, local, addr)
+	assert.Nil(t, forward)
+
+	// the deposit falls back to the given local account, not the one of the receiver's bytes
+	addr, forward, err = ParseDepositReceiver("channel-0/" + osmo + "/" + fallback.String())
+	require.NoError(t, err)
+	assert.Equal(t, fallback, addr)
+	assert.Equal(t, &IBCForward{Channel: "channel-0", Receiver: osmo, Timeout: DefaultIBCForwardTimeout}, forward)
+
+	_, forward, err = ParseDepositReceiver("channel-7/" + long + "/" + fallback.String() + "/600")
+	require.NoError(t, err)
+	assert.Equal(t, &IBCForward{Channel: "channel-7", Receiver: long, Timeout: 10 * time.Minute}, forward)
+
+	for _, receiver := range []string{
+		"",
+		"0x00000000000000000003",
+		osmo,
+		"channel-0/" + osmo,
+		"channel-0/" + osmo + "/600",
+		"channel-0//" + fallback.String(),
+		"/" + osmo + "/" + fallback.String(),
+		"ch/" + osmo + "/" + fallback.String(),
+		"channel-0/not-an-address/" + fallback.String(),
+		"channel-0/" + osmo + "/" + osmo,
+		"channel-0/" + osmo + "/" + fallback.String() + "/0",
+		"channel-0/" + osmo + "/" + fallback.String() + "/-5",
+		"channel-0/" + osmo + "/" + fallback.String() + "/10m",
+		"channel-0/" + osmo + "/" + fallback.String() + "/600/extra",
+	} {
+		_, _, err := ParseDepositReceiver(receiver)
+		assert.Error(t, err, receiver)
+	}
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/hex"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -11,6 +13,9 @@ const (
 	// StoreKey to be used when creating the KVStore
 	StoreKey = ModuleName
 
+	// TStoreKey to be used when creating the transient store, which is cleared at the end of every block
+	TStoreKey = "transient_" + ModuleName
+
 	// RouterKey is the module name router key
 	RouterKey = ModuleName
 
@@ -124,6 +129,69 @@ var (
 
 	// PastEthSignatureCheckpointKey indexes eth signature checkpoints that have existed
 	PastEthSignatureCheckpointKey = []byte{0x1b}
+
+	// ConsecutiveBatchTimeoutsKey indexes the number of batches in a row that have timed out for a token contract
+	ConsecutiveBatchTimeoutsKey = []byte{0x21}
+
+	// SuspendedTokenKey indexes token contracts that have been suspended for timing out too many batches
+	SuspendedTokenKey = []byte{0x22}
+
+	// EthereumHeightAttestationKey indexes Ethereum height claim attestations by height nonce and claim hash,
+	// these are kept apart from OracleAttestationKey since they do not use event nonces
+	EthereumHeightAttestationKey = []byte{0x23}
+
+	// LastObservedEthereumHeightNonceKey indexes the latest observed Ethereum height claim nonce
+	LastObservedEthereumHeightNonceKey = []byte{0x24}
+
+	// OrchestratorVersionKey indexes the last Ethereum height claim nonce and orchestrator version by validator
+	OrchestratorVersionKey = []byte{0x25}
+
+	// CosmosBlockTimeKey indexes the measured moving average of the Cosmos block time
+	CosmosBlockTimeKey = []byte{0x26}
+
+	// EthereumBlockTimeKey indexes the measured moving average of the Ethereum block time
+	EthereumBlockTimeKey = []byte{0x27}
+
+	// ConfirmLivenessInfoKey indexes the confirm liveness info of each validator by confirm type
+	ConfirmLivenessInfoKey = []byte{0x28}
+
+	// MissedConfirmBitArrayKey indexes the items each validator has failed to confirm by confirm type and
+	// index in the confirms window
+	MissedConfirmBitArrayKey = []byte{0x29}
+
+	// BadSignatureEvidenceKey indexes processed bad signature evidence by checkpoint and Ethereum signer
+	BadSignatureEvidenceKey = []byte{0x2a}
+
+	// ValidatorAwaitingKeysKey indexes the height at which bonded validators without delegate keys were bonded
+	ValidatorAwaitingKeysKey = []byte{0x2b}
+
+	// LastKeyRegistrationBlockHeightKey indexes the last block height a newly bonded validator registered delegate keys
+	LastKeyRegistrationBlockHeightKey = []byte{0x2c}
+
+	// PendingConfirmPruneKey indexes the confirms of deleted valsets, batches and logic calls by the height at which
+	// they can be pruned
+	PendingConfirmPruneKey = []byte{0x2d}
+
+	// ObservedEventRecordKey indexes the archive of observed events by event nonce
+	ObservedEventRecordKey = []byte{0x2e}
+
+	// FeeExemptMsgCountKey indexes the number of fee exempt messages an orchestrator submitted in the current
+	// block, it is kept in the transient store
+	FeeExemptMsgCountKey = []byte{0x2f}
+
+	// BatchTimeoutHeightKey indexes the block height at which a batch timeout was last counted for a token
+	// contract, it is kept in the transient store
+	BatchTimeoutHeightKey = []byte{0x30}
+
+	// PastEthSignatureNonceKey indexes when the first checkpoint for a signed nonce was stored
+	PastEthSignatureNonceKey = []byte{0x31}
+
+	// PendingDepositForwardKey indexes deposits forwarded over IBC by the channel and sequence of their packet
+	// until the packet is acknowledged or times out
+	PendingDepositForwardKey = []byte{0x32}
+
+	// KeyValidatorOrchestratorAddress indexes the orchestrator of a validator, the reverse of KeyOrchestratorAddress
+	KeyValidatorOrchestratorAddress = []byte{0x33}
 )
 
 // GetOrchestratorAddressKey returns the following key format
@@ -133,6 +201,13 @@ func GetOrchestratorAddressKey(orc sdk.AccAddress) []byte {
 	return append(KeyOrchestratorAddress, orc.Bytes()...)
 }
 
+// GetValidatorOrchestratorAddressKey returns the following key format
+// prefix cosmos-validator
+// [0x33][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func GetValidatorOrchestratorAddressKey(validator sdk.ValAddress) []byte {
+	return append(KeyValidatorOrchestratorAddress, validator.Bytes()...)
+}
+
 // GetEthAddressByValidatorKey returns the following key format
 // prefix              cosmos-validator
 // [0x0][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
@@ -282,3 +357,123 @@ func GetLogicConfirmKey(invalidationId []byte, invalidationNonce uint64, validat
 func GetPastEthSignatureCheckpointKey(checkpoint []byte) []byte {
 	return append(PastEthSignatureCheckpointKey, checkpoint...)
 }
+
+// GetPastEthSignatureNonceKey returns the following key format
+// prefix    signed nonce
+// [0x31][ EthereumSigned.GetSignedNonce() ]
+func GetPastEthSignatureNonceKey(signedNonce []byte) []byte {
+	return append(PastEthSignatureNonceKey, signedNonce...)
+}
+
+// GetConsecutiveBatchTimeoutsKey returns the following key format
+// prefix     eth-contract-address
+// [0x21][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetConsecutiveBatchTimeoutsKey(tokenContract string) []byte {
+	return append(ConsecutiveBatchTimeoutsKey, []byte(tokenContract)...)
+}
+
+// GetSuspendedTokenKey returns the following key format
+// prefix     eth-contract-address
+// [0x22][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetSuspendedTokenKey(tokenContract string) []byte {
+	return append(SuspendedTokenKey, []byte(tokenContract)...)
+}
+
+// GetEthereumHeightAttestationKey returns the following key format
+// prefix     height-nonce                      claim-details-hash
+// [0x23][0 0 0 0 0 0 0 1][fd1af8cec6c67fcf156f1b61fdf91ebc04d05484d007436e75342fc05bbff35a]
+func GetEthereumHeightAttestationKey(heightNonce uint64, claimHash []byte) []byte {
+	return append(append(EthereumHeightAttestationKey, UInt64Bytes(heightNonce)...), claimHash...)
+}
+
+// GetClaimAttestationKey returns the key the attestation for the given claim is stored under, Ethereum
+// height claims have their own nonce space and are stored apart from Ethereum events
+func GetClaimAttestationKey(claim EthereumClaim) []byte {
+	if claim.GetType() == CLAIM_TYPE_ETHEREUM_HEIGHT {
+		return GetEthereumHeightAttestationKey(claim.GetEventNonce(), claim.ClaimHash())
+	}
+	return GetAttestationKey(claim.GetEventNonce(), claim.ClaimHash())
+}
+
+// GetAttestationID returns the hex encoded attestation key of the given claim, it identifies the attestation
+// in events
+func GetAttestationID(claim EthereumClaim) string {
+	return hex.EncodeToString(GetClaimAttestationKey(claim))
+}
+
+// GetOrchestratorVersionKey returns the following key format
+// prefix     cosmos-validator
+// [0x25][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func GetOrchestratorVersionKey(validator sdk.ValAddress) []byte {
+	return append(OrchestratorVersionKey, validator.Bytes()...)
+}
+
+// GetConfirmLivenessInfoKey returns the following key format
+// prefix confirm-type cosmos-validator
+// [0x28][0x2][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func GetConfirmLivenessInfoKey(confirmType ConfirmType, validator sdk.ValAddress) []byte {
+	return append(append(ConfirmLivenessInfoKey, byte(confirmType)), validator.Bytes()...)
+}
+
+// GetMissedConfirmBitArrayPrefix returns the following key format
+// prefix confirm-type cosmos-validator
+// [0x29][0x2][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func GetMissedConfirmBitArrayPrefix(confirmType ConfirmType, validator sdk.ValAddress) []byte {
+	return append(append(MissedConfirmBitArrayKey, byte(confirmType)), validator.Bytes()...)
+}
+
+// GetMissedConfirmBitArrayKey returns the following key format
+// prefix confirm-type cosmos-validator                                      index
+// [0x29][0x2][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn][0 0 0 0 0 0 0 7]
+func GetMissedConfirmBitArrayKey(confirmType ConfirmType, validator sdk.ValAddress, index uint64) []byte {
+	return append(GetMissedConfirmBitArrayPrefix(confirmType, validator), UInt64Bytes(index)...)
+}
+
+// GetBadSignatureEvidenceKey returns the following key format
+// prefix checkpoint           eth-signer-address
+// [0x2a][ checkpoint bytes ][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetBadSignatureEvidenceKey(checkpoint []byte, ethSigner string) []byte {
+	return append(append(BadSignatureEvidenceKey, checkpoint...), []byte(ethSigner)...)
+}
+
+// GetValidatorAwaitingKeysKey returns the following key format
+// prefix cosmos-validator
+// [0x2b][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func GetValidatorAwaitingKeysKey(validator sdk.ValAddress) []byte {
+	return append(ValidatorAwaitingKeysKey, validator.Bytes()...)
+}
+
+// GetPendingConfirmPruneKey returns the following key format
+// prefix prune-height       confirm-prefix
+// [0x2d][0 0 0 0 0 0 0 7][0xc][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func GetPendingConfirmPruneKey(height uint64, confirmPrefix []byte) []byte {
+	return append(append(PendingConfirmPruneKey, UInt64Bytes(height)...), confirmPrefix...)
+}
+
+// GetObservedEventRecordKey returns the following key format
+// prefix event-nonce
+// [0x2e][0 0 0 0 0 0 0 1]
+func GetObservedEventRecordKey(eventNonce uint64) []byte {
+	return append(ObservedEventRecordKey, UInt64Bytes(eventNonce)...)
+}
+
+// GetFeeExemptMsgCountKey returns the following key format
+// prefix orchestrator-address
+// [0x2f][cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func GetFeeExemptMsgCountKey(orchestrator sdk.AccAddress) []byte {
+	return append(FeeExemptMsgCountKey, orchestrator.Bytes()...)
+}
+
+// GetBatchTimeoutHeightKey returns the following key format
+// prefix     eth-contract-address
+// [0x30][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetBatchTimeoutHeightKey(tokenContract string) []byte {
+	return append(BatchTimeoutHeightKey, []byte(tokenContract)...)
+}
+
+// GetPendingDepositForwardKey returns the following key format
+// prefix channel-id  packet-sequence
+// [0x32][channel-0][0 0 0 0 0 0 0 1]
+func GetPendingDepositForwardKey(channel string, sequence uint64) []byte {
+	return append(append(PendingDepositForwardKey, []byte(channel)...), UInt64Bytes(sequence)...)
+}
@@ -4,8 +4,10 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 )
 
@@ -24,6 +26,10 @@ var (
 	_ sdk.Msg = &MsgBatchSendToEthClaim{}
 	_ sdk.Msg = &MsgValsetUpdatedClaim{}
 	_ sdk.Msg = &MsgSubmitBadSignatureEvidence{}
+	_ sdk.Msg = &MsgEthereumHeightClaim{}
+	_ sdk.Msg = &MsgSubmitClaims{}
+
+	_ codectypes.UnpackInterfacesMessage = &MsgSubmitClaims{}
 )
 
 // NewMsgSetOrchestratorAddress returns a new msgSetOrchestratorAddress
@@ -318,6 +324,7 @@ var (
 	_ EthereumClaim = &MsgBatchSendToEthClaim{}
 	_ EthereumClaim = &MsgERC20DeployedClaim{}
 	_ EthereumClaim = &MsgLogicCallExecutedClaim{}
+	_ EthereumClaim = &MsgEthereumHeightClaim{}
 )
 
 // GetType returns the type of the claim
@@ -327,8 +334,8 @@ func (msg *MsgSendToCosmosClaim) GetType() ClaimType {
 
 // ValidateBasic performs stateless checks
 func (msg *MsgSendToCosmosClaim) ValidateBasic() error {
-	if _, err := sdk.AccAddressFromBech32(msg.CosmosReceiver); err != nil {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.CosmosReceiver)
+	if _, _, err := ParseDepositReceiver(msg.CosmosReceiver); err != nil {
+		return sdkerrors.Wrap(err, "cosmos receiver")
 	}
 	if err := ValidateEthAddress(msg.EthereumSender); err != nil {
 		return sdkerrors.Wrap(err, "eth sender")
@@ -654,6 +661,109 @@ func (b *MsgValsetUpdatedClaim) ClaimHash() []byte {
 	return tmhash.Sum([]byte(path))
 }
 
+// EthereumClaim implementation for MsgEthereumHeightClaim
+// ======================================================
+
+// EthereumHeightClaimGranularity is the number of Ethereum blocks that reported heights
+// are rounded down to, orchestrators will rarely see exactly the same latest block so without
+// rounding their votes would be split across many slightly different claims
+const EthereumHeightClaimGranularity = 10
+
+// EthereumHeightClaimTimeout is the number of blocks an Ethereum height claim attestation may stay pending before
+// it is pruned, heartbeats are only useful while they are recent and a claim for a height no other orchestrator
+// agrees with would otherwise be stored forever
+const EthereumHeightClaimTimeout = 500
+
+// MaxOrchestratorVersionLength bounds the version string stored for every validator
+const MaxOrchestratorVersionLength = 64
+
+// NewMsgEthereumHeightClaim returns a new MsgEthereumHeightClaim for the given Ethereum block height, the height is
+// rounded down to a multiple of EthereumHeightClaimGranularity and the height nonce is derived from it
+func NewMsgEthereumHeightClaim(ethereumHeight uint64, orchestrator sdk.AccAddress, version string) *MsgEthereumHeightClaim {
+	heightNonce := ethereumHeight / EthereumHeightClaimGranularity
+	return &MsgEthereumHeightClaim{
+		HeightNonce:         heightNonce,
+		BlockHeight:         heightNonce * EthereumHeightClaimGranularity,
+		Orchestrator:        orchestrator.String(),
+		OrchestratorVersion: version,
+	}
+}
+
+// GetType returns the type of the claim
+func (e *MsgEthereumHeightClaim) GetType() ClaimType {
+	return CLAIM_TYPE_ETHEREUM_HEIGHT
+}
+
+// GetEventNonce returns the height nonce of the claim, height claims are not Ethereum events
+// and are counted in their own nonce space so they never consume an event nonce
+func (e *MsgEthereumHeightClaim) GetEventNonce() uint64 {
+	return e.HeightNonce
+}
+
+// ValidateBasic performs stateless checks
+func (e *MsgEthereumHeightClaim) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(e.Orchestrator); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, e.Orchestrator)
+	}
+	if e.HeightNonce == 0 {
+		return fmt.Errorf("nonce == 0")
+	}
+	if e.BlockHeight == 0 {
+		return fmt.Errorf("block height == 0")
+	}
+	if e.BlockHeight%EthereumHeightClaimGranularity != 0 {
+		return sdkerrors.Wrapf(ErrInvalid, "block height must be a multiple of %d", EthereumHeightClaimGranularity)
+	}
+	if e.HeightNonce != e.BlockHeight/EthereumHeightClaimGranularity {
+		return sdkerrors.Wrapf(ErrInvalid, "height nonce must be the block height divided by %d", EthereumHeightClaimGranularity)
+	}
+	if len(e.OrchestratorVersion) > MaxOrchestratorVersionLength {
+		return sdkerrors.Wrapf(ErrInvalid, "orchestrator version longer than %d", MaxOrchestratorVersionLength)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgEthereumHeightClaim) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgEthereumHeightClaim) GetClaimer() sdk.AccAddress {
+	err := msg.ValidateBasic()
+	if err != nil {
+		panic("MsgEthereumHeightClaim failed ValidateBasic! Should have been handled earlier")
+	}
+
+	val, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
+	return val
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgEthereumHeightClaim) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Orchestrator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
+
+// Type should return the action
+func (msg MsgEthereumHeightClaim) Type() string { return "Ethereum_Height_Claim" }
+
+// Route should return the name of the module
+func (msg MsgEthereumHeightClaim) Route() string { return RouterKey }
+
+// Hash implements BridgeDeposit.Hash
+// modify this with care as it is security sensitive. If an element of the claim is not in this hash a single hostile validator
+// could engineer a hash collision and execute a version of the claim with any unhashed data changed to benefit them.
+// the Orchestrator and OrchestratorVersion are excluded from this hash, the version is informational and differs between
+// validators who otherwise agree on the claim
+func (b *MsgEthereumHeightClaim) ClaimHash() []byte {
+	path := fmt.Sprintf("%d/%d", b.HeightNonce, b.BlockHeight)
+	return tmhash.Sum([]byte(path))
+}
+
 // NewMsgCancelSendToEth returns a new msgSetOrchestratorAddress
 func NewMsgCancelSendToEth(user sdk.AccAddress, id uint64) *MsgCancelSendToEth {
 	return &MsgCancelSendToEth{
@@ -722,3 +832,104 @@ func (msg MsgSubmitBadSignatureEvidence) Type() string { return "Submit_Bad_Sign
 
 // Route should return the name of the module
 func (msg MsgSubmitBadSignatureEvidence) Route() string { return RouterKey }
+
+// MsgSubmitClaims
+// ======================================================
+
+// NewMsgSubmitClaims packs the given claims into a MsgSubmitClaims, the claims
+// are expected to already be in event nonce order
+func NewMsgSubmitClaims(orchestrator sdk.AccAddress, claims ...EthereumClaim) (*MsgSubmitClaims, error) {
+	anys := make([]*codectypes.Any, len(claims))
+	for i, claim := range claims {
+		pb, ok := claim.(proto.Message)
+		if !ok {
+			return nil, sdkerrors.Wrapf(ErrInvalid, "claim %d is not a proto message", i)
+		}
+		any, err := codectypes.NewAnyWithValue(pb)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
+	}
+	return &MsgSubmitClaims{
+		Orchestrator: orchestrator.String(),
+		Claims:       anys,
+	}, nil
+}
+
+// GetEthereumClaims returns the unpacked claims of this message in order
+func (msg *MsgSubmitClaims) GetEthereumClaims() ([]EthereumClaim, error) {
+	claims := make([]EthereumClaim, len(msg.Claims))
+	for i, any := range msg.Claims {
+		if any == nil {
+			return nil, sdkerrors.Wrapf(ErrEmpty, "claim %d", i)
+		}
+		claim, ok := any.GetCachedValue().(EthereumClaim)
+		if !ok {
+			return nil, sdkerrors.Wrapf(ErrInvalid, "claim %d is not an ethereum claim", i)
+		}
+		claims[i] = claim
+	}
+	return claims, nil
+}
+
+// ValidateBasic performs stateless checks
+func (msg *MsgSubmitClaims) ValidateBasic() error {
+	orchestrator, err := sdk.AccAddressFromBech32(msg.Orchestrator)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Orchestrator)
+	}
+	if len(msg.Claims) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "claims")
+	}
+	claims, err := msg.GetEthereumClaims()
+	if err != nil {
+		return err
+	}
+	for i, claim := range claims {
+		if claim.GetType() == CLAIM_TYPE_ETHEREUM_HEIGHT {
+			return sdkerrors.Wrapf(ErrInvalid, "claim %d: ethereum height claims can not be batched", i)
+		}
+		if err := claim.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "claim %d", i)
+		}
+		if !claim.GetClaimer().Equals(orchestrator) {
+			return sdkerrors.Wrapf(sdkerrors.ErrorInvalidSigner, "claim %d not signed by orchestrator", i)
+		}
+		if i > 0 && claim.GetEventNonce() != claims[i-1].GetEventNonce()+1 {
+			return sdkerrors.Wrapf(ErrNonContiguousEventNonce, "claim %d", i)
+		}
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSubmitClaims) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSubmitClaims) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Orchestrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// Type should return the action
+func (msg MsgSubmitClaims) Type() string { return "submit_claims" }
+
+// Route should return the name of the module
+func (msg MsgSubmitClaims) Route() string { return RouterKey }
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg *MsgSubmitClaims) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, any := range msg.Claims {
+		var claim EthereumClaim
+		if err := unpacker.UnpackAny(any, &claim); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -1521,6 +1521,287 @@ func (m *MsgSubmitBadSignatureEvidenceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitBadSignatureEvidenceResponse proto.InternalMessageInfo
 
+// EthereumHeightClaim is a heartbeat claim reporting the current Ethereum
+// block height, it is attested to like any other claim but uses its own
+// nonce space (height_nonce) so that it never consumes an event nonce.
+// Once observed it updates the last observed Ethereum block height, which
+// allows batches and logic calls to time out on bridges with no deposits or
+// withdraws. block_height must be a multiple of EthereumHeightClaimGranularity
+// so that orchestrators observing slightly different heights vote on the same
+// claim, and height_nonce must be block_height divided by it so that every
+// orchestrator derives the same nonce independently. The orchestrator_version is not part of the claim, it is recorded
+// per validator to track which orchestrator software is running.
+type MsgEthereumHeightClaim struct {
+	HeightNonce         uint64 `protobuf:"varint,1,opt,name=height_nonce,json=heightNonce,proto3" json:"height_nonce,omitempty"`
+	BlockHeight         uint64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Orchestrator        string `protobuf:"bytes,3,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
+	OrchestratorVersion string `protobuf:"bytes,4,opt,name=orchestrator_version,json=orchestratorVersion,proto3" json:"orchestrator_version,omitempty"`
+}
+
+func (m *MsgEthereumHeightClaim) Reset()         { *m = MsgEthereumHeightClaim{} }
+func (m *MsgEthereumHeightClaim) String() string { return proto.CompactTextString(m) }
+func (*MsgEthereumHeightClaim) ProtoMessage()    {}
+func (*MsgEthereumHeightClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{26}
+}
+func (m *MsgEthereumHeightClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEthereumHeightClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEthereumHeightClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEthereumHeightClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEthereumHeightClaim.Merge(m, src)
+}
+func (m *MsgEthereumHeightClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEthereumHeightClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEthereumHeightClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEthereumHeightClaim proto.InternalMessageInfo
+
+func (m *MsgEthereumHeightClaim) GetHeightNonce() uint64 {
+	if m != nil {
+		return m.HeightNonce
+	}
+	return 0
+}
+
+func (m *MsgEthereumHeightClaim) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *MsgEthereumHeightClaim) GetOrchestrator() string {
+	if m != nil {
+		return m.Orchestrator
+	}
+	return ""
+}
+
+func (m *MsgEthereumHeightClaim) GetOrchestratorVersion() string {
+	if m != nil {
+		return m.OrchestratorVersion
+	}
+	return ""
+}
+
+type MsgEthereumHeightClaimResponse struct {
+}
+
+func (m *MsgEthereumHeightClaimResponse) Reset()         { *m = MsgEthereumHeightClaimResponse{} }
+func (m *MsgEthereumHeightClaimResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEthereumHeightClaimResponse) ProtoMessage()    {}
+func (*MsgEthereumHeightClaimResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{27}
+}
+func (m *MsgEthereumHeightClaimResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEthereumHeightClaimResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEthereumHeightClaimResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEthereumHeightClaimResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEthereumHeightClaimResponse.Merge(m, src)
+}
+func (m *MsgEthereumHeightClaimResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEthereumHeightClaimResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEthereumHeightClaimResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEthereumHeightClaimResponse proto.InternalMessageInfo
+
+// MsgSubmitClaims
+// this message allows an orchestrator to submit several Ethereum event claims
+// in a single message. The claims must be ordered by event nonce, each one
+// exactly one higher than the last, and are processed atomically: if any
+// claim is rejected none of them are recorded.
+// CLAIMS
+// The claims field holds any of MsgSendToCosmosClaim, MsgBatchSendToEthClaim,
+// MsgERC20DeployedClaim, MsgLogicCallExecutedClaim and MsgValsetUpdatedClaim,
+// all of which must have been signed by the orchestrator of this message.
+// Ethereum height claims use their own nonce space and can not be batched.
+type MsgSubmitClaims struct {
+	Orchestrator string        `protobuf:"bytes,1,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
+	Claims       []*types1.Any `protobuf:"bytes,2,rep,name=claims,proto3" json:"claims,omitempty"`
+}
+
+func (m *MsgSubmitClaims) Reset()         { *m = MsgSubmitClaims{} }
+func (m *MsgSubmitClaims) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitClaims) ProtoMessage()    {}
+func (*MsgSubmitClaims) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{28}
+}
+func (m *MsgSubmitClaims) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitClaims) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitClaims.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitClaims) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitClaims.Merge(m, src)
+}
+func (m *MsgSubmitClaims) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitClaims) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitClaims.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitClaims proto.InternalMessageInfo
+
+func (m *MsgSubmitClaims) GetOrchestrator() string {
+	if m != nil {
+		return m.Orchestrator
+	}
+	return ""
+}
+
+func (m *MsgSubmitClaims) GetClaims() []*types1.Any {
+	if m != nil {
+		return m.Claims
+	}
+	return nil
+}
+
+// ClaimResult reports the outcome of a single claim in a MsgSubmitClaims
+type ClaimResult struct {
+	EventNonce uint64    `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	ClaimType  ClaimType `protobuf:"varint,2,opt,name=claim_type,json=claimType,proto3,enum=gravity.v1.ClaimType" json:"claim_type,omitempty"`
+	ClaimHash  []byte    `protobuf:"bytes,3,opt,name=claim_hash,json=claimHash,proto3" json:"claim_hash,omitempty"`
+}
+
+func (m *ClaimResult) Reset()         { *m = ClaimResult{} }
+func (m *ClaimResult) String() string { return proto.CompactTextString(m) }
+func (*ClaimResult) ProtoMessage()    {}
+func (*ClaimResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{29}
+}
+func (m *ClaimResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimResult.Merge(m, src)
+}
+func (m *ClaimResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimResult proto.InternalMessageInfo
+
+func (m *ClaimResult) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
+}
+
+func (m *ClaimResult) GetClaimType() ClaimType {
+	if m != nil {
+		return m.ClaimType
+	}
+	return CLAIM_TYPE_UNSPECIFIED
+}
+
+func (m *ClaimResult) GetClaimHash() []byte {
+	if m != nil {
+		return m.ClaimHash
+	}
+	return nil
+}
+
+type MsgSubmitClaimsResponse struct {
+	Results []ClaimResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+}
+
+func (m *MsgSubmitClaimsResponse) Reset()         { *m = MsgSubmitClaimsResponse{} }
+func (m *MsgSubmitClaimsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitClaimsResponse) ProtoMessage()    {}
+func (*MsgSubmitClaimsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{30}
+}
+func (m *MsgSubmitClaimsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitClaimsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitClaimsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitClaimsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitClaimsResponse.Merge(m, src)
+}
+func (m *MsgSubmitClaimsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitClaimsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitClaimsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitClaimsResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitClaimsResponse) GetResults() []ClaimResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgSetOrchestratorAddress)(nil), "gravity.v1.MsgSetOrchestratorAddress")
 	proto.RegisterType((*MsgSetOrchestratorAddressResponse)(nil), "gravity.v1.MsgSetOrchestratorAddressResponse")
@@ -1548,111 +1829,130 @@ func init() {
 	proto.RegisterType((*MsgCancelSendToEthResponse)(nil), "gravity.v1.MsgCancelSendToEthResponse")
 	proto.RegisterType((*MsgSubmitBadSignatureEvidence)(nil), "gravity.v1.MsgSubmitBadSignatureEvidence")
 	proto.RegisterType((*MsgSubmitBadSignatureEvidenceResponse)(nil), "gravity.v1.MsgSubmitBadSignatureEvidenceResponse")
+	proto.RegisterType((*MsgEthereumHeightClaim)(nil), "gravity.v1.MsgEthereumHeightClaim")
+	proto.RegisterType((*MsgEthereumHeightClaimResponse)(nil), "gravity.v1.MsgEthereumHeightClaimResponse")
+	proto.RegisterType((*MsgSubmitClaims)(nil), "gravity.v1.MsgSubmitClaims")
+	proto.RegisterType((*ClaimResult)(nil), "gravity.v1.ClaimResult")
+	proto.RegisterType((*MsgSubmitClaimsResponse)(nil), "gravity.v1.MsgSubmitClaimsResponse")
 }
 
 func init() { proto.RegisterFile("gravity/v1/msgs.proto", fileDescriptor_2f8523f2f6feb451) }
 
 var fileDescriptor_2f8523f2f6feb451 = []byte{
-	// 1570 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0x1c, 0xc5,
-	0x12, 0xf7, 0xd8, 0x6b, 0x3b, 0xae, 0xf5, 0x47, 0x32, 0x71, 0x9c, 0xf5, 0xd8, 0x59, 0xdb, 0x93,
-	0xf8, 0x23, 0x2f, 0xcf, 0xbb, 0xb1, 0x9f, 0x9e, 0xb8, 0x81, 0xb2, 0x1b, 0x07, 0x22, 0xe1, 0x20,
-	0xad, 0x43, 0x0e, 0x08, 0x69, 0xd4, 0x3b, 0xd3, 0x99, 0x1d, 0x32, 0x33, 0x6d, 0x66, 0x7a, 0x37,
-	0xf1, 0x25, 0x12, 0x70, 0x42, 0xe1, 0xc0, 0xc7, 0x0d, 0xc1, 0x9f, 0x80, 0xb8, 0x70, 0xe2, 0xc2,
-	0x35, 0xe2, 0x80, 0x82, 0xb8, 0x20, 0x90, 0x22, 0x94, 0xf0, 0x87, 0xa0, 0xe9, 0xee, 0x69, 0xf7,
-	0xce, 0xcc, 0xda, 0x06, 0x99, 0x93, 0xb7, 0xab, 0xab, 0xbb, 0x7e, 0xf5, 0xab, 0xea, 0xaa, 0xf2,
-	0xc0, 0x05, 0x37, 0x42, 0x3d, 0x8f, 0x1e, 0xd4, 0x7b, 0x5b, 0xf5, 0x20, 0x76, 0xe3, 0xda, 0x7e,
-	0x44, 0x28, 0xd1, 0x41, 0x88, 0x6b, 0xbd, 0x2d, 0xa3, 0x6a, 0x93, 0x38, 0x20, 0x71, 0xbd, 0x8d,
-	0x62, 0x5c, 0xef, 0x6d, 0xb5, 0x31, 0x45, 0x5b, 0x75, 0x9b, 0x78, 0x21, 0xd7, 0x35, 0x66, 0x5d,
-	0xe2, 0x12, 0xf6, 0xb3, 0x9e, 0xfc, 0x12, 0xd2, 0x45, 0x97, 0x10, 0xd7, 0xc7, 0x75, 0xb4, 0xef,
-	0xd5, 0x51, 0x18, 0x12, 0x8a, 0xa8, 0x47, 0x42, 0x71, 0xbf, 0x31, 0xa7, 0x98, 0xa5, 0x07, 0xfb,
-	0x38, 0x95, 0xcf, 0x8b, 0x53, 0x6c, 0xd5, 0xee, 0xde, 0xaf, 0xa3, 0xf0, 0x20, 0xdd, 0xe2, 0x30,
-	0x2c, 0x6e, 0x89, 0x2f, 0xf8, 0x96, 0xf9, 0x18, 0xe6, 0x77, 0x63, 0x77, 0x0f, 0xd3, 0xb7, 0x22,
-	0xbb, 0x83, 0x63, 0x1a, 0x21, 0x4a, 0xa2, 0x1b, 0x8e, 0x13, 0xe1, 0x38, 0xd6, 0x17, 0x61, 0xa2,
-	0x87, 0x7c, 0xcf, 0x49, 0x64, 0x15, 0x6d, 0x59, 0xdb, 0x98, 0x68, 0x1d, 0x0a, 0x74, 0x13, 0x26,
-	0x89, 0x72, 0xa8, 0x32, 0xcc, 0x14, 0xfa, 0x64, 0xfa, 0x12, 0x94, 0x31, 0xed, 0x58, 0x88, 0x5f,
-	0x58, 0x19, 0x61, 0x2a, 0x80, 0x69, 0x47, 0x98, 0x30, 0x2f, 0xc3, 0xca, 0x40, 0xfb, 0x2d, 0x1c,
-	0xef, 0x93, 0x30, 0xc6, 0xe6, 0x13, 0x0d, 0xce, 0xee, 0xc6, 0xee, 0x3d, 0xe4, 0xc7, 0x98, 0x36,
-	0x49, 0x78, 0xdf, 0x8b, 0x02, 0x7d, 0x16, 0x46, 0x43, 0x12, 0xda, 0x98, 0x01, 0x2b, 0xb5, 0xf8,
-	0xe2, 0x54, 0x40, 0x25, 0x7e, 0xc7, 0x9e, 0x1b, 0x22, 0xda, 0x8d, 0x70, 0xa5, 0xc4, 0xfd, 0x96,
-	0x02, 0xd3, 0x80, 0x4a, 0x16, 0x8c, 0x44, 0xfa, 0xbd, 0x06, 0x93, 0xcc, 0x9f, 0xd0, 0xb9, 0x4b,
-	0x76, 0x68, 0x47, 0x9f, 0x83, 0xb1, 0x18, 0x87, 0x0e, 0x4e, 0xf9, 0x13, 0x2b, 0x7d, 0x1e, 0xce,
-	0x24, 0x18, 0x1c, 0x1c, 0x53, 0x81, 0x71, 0x1c, 0xd3, 0xce, 0x4d, 0x1c, 0x53, 0xfd, 0x15, 0x18,
-	0x43, 0x01, 0xe9, 0x86, 0x94, 0x21, 0x2b, 0x6f, 0xcf, 0xd7, 0x44, 0xc4, 0x92, 0x2c, 0xaa, 0x89,
-	0x2c, 0xaa, 0x35, 0x89, 0x17, 0x36, 0x4a, 0x4f, 0x9f, 0x2f, 0x0d, 0xb5, 0x84, 0xba, 0xfe, 0x2a,
-	0x40, 0x3b, 0xf2, 0x1c, 0x17, 0x5b, 0xf7, 0x31, 0xc7, 0x7d, 0x82, 0xc3, 0x13, 0xfc, 0xc8, 0x2d,
-	0x8c, 0xcd, 0x39, 0x98, 0x55, 0xb1, 0x4b, 0xa7, 0x5e, 0x83, 0x99, 0xdd, 0xd8, 0x6d, 0xe1, 0xf7,
-	0xbb, 0x38, 0xa6, 0x0d, 0x44, 0xed, 0xc1, 0x6e, 0xcd, 0xc2, 0xa8, 0x83, 0x43, 0x12, 0x08, 0x9f,
-	0xf8, 0xc2, 0x9c, 0x87, 0x8b, 0x99, 0x0b, 0xe4, 0xdd, 0xdf, 0x6a, 0xec, 0x72, 0xc1, 0x23, 0xbf,
-	0xbc, 0x38, 0xb2, 0xab, 0x30, 0x4d, 0xc9, 0x03, 0x1c, 0x5a, 0x36, 0x09, 0x69, 0x84, 0xec, 0x94,
-	0xb7, 0x29, 0x26, 0x6d, 0x0a, 0xa1, 0x7e, 0x09, 0x92, 0x48, 0x5a, 0x49, 0xb8, 0x70, 0x24, 0x62,
-	0x3b, 0x81, 0x69, 0x67, 0x8f, 0x09, 0x72, 0xf9, 0x51, 0x2a, 0xc8, 0x8f, 0xbe, 0xf0, 0x8f, 0x66,
-	0xc3, 0xcf, 0x9d, 0x51, 0x01, 0x4b, 0x67, 0x7e, 0xd2, 0xe0, 0xfc, 0xe1, 0xde, 0x9b, 0xc4, 0xf5,
-	0xec, 0x26, 0xf2, 0x7d, 0x7d, 0x1d, 0x66, 0xbc, 0x50, 0x3c, 0x1c, 0x8f, 0x84, 0x96, 0xe7, 0x08,
-	0xda, 0xa6, 0x55, 0xf1, 0x6d, 0x47, 0xdf, 0x04, 0xbd, 0x4f, 0x91, 0xd3, 0x30, 0xcc, 0x68, 0x38,
-	0xa7, 0xee, 0xdc, 0x61, 0x94, 0xfc, 0xeb, 0xbe, 0x5e, 0x82, 0x85, 0x02, 0x7f, 0xa4, 0xbf, 0x3f,
-	0x0c, 0x2b, 0x19, 0xd3, 0x64, 0x79, 0xd6, 0xf4, 0x91, 0x17, 0xb0, 0x17, 0xd6, 0xc3, 0x21, 0xb5,
-	0xd4, 0x38, 0x02, 0x13, 0x71, 0xe4, 0x2b, 0x30, 0xd9, 0xf6, 0x89, 0xfd, 0xc0, 0xea, 0x60, 0xcf,
-	0xed, 0x50, 0xe1, 0x62, 0x99, 0xc9, 0xde, 0x60, 0xa2, 0x82, 0x78, 0x8f, 0x14, 0xc5, 0xfb, 0x96,
-	0x7c, 0x2d, 0xcc, 0xbd, 0x46, 0x2d, 0xc9, 0xea, 0xdf, 0x9e, 0x2f, 0xad, 0xb9, 0x1e, 0xed, 0x74,
-	0xdb, 0x35, 0x9b, 0x04, 0xa2, 0xe2, 0x89, 0x3f, 0x9b, 0xb1, 0xf3, 0x40, 0x14, 0xce, 0xdb, 0x21,
-	0x95, 0x8f, 0x67, 0x1d, 0x66, 0x30, 0xed, 0xe0, 0x08, 0x77, 0x03, 0x4b, 0xa4, 0x36, 0xa7, 0x63,
-	0x3a, 0x15, 0xef, 0xf1, 0x14, 0x5f, 0x87, 0x19, 0x51, 0x4e, 0x23, 0x6c, 0x63, 0xaf, 0x87, 0xa3,
-	0xca, 0x18, 0x57, 0xe4, 0xe2, 0x96, 0x90, 0xe6, 0xe8, 0x1f, 0xcf, 0xd3, 0x6f, 0x56, 0x61, 0xb1,
-	0x88, 0x40, 0xc9, 0xf0, 0x53, 0x0d, 0xe6, 0x76, 0x63, 0x97, 0xa5, 0x99, 0x7c, 0x98, 0xa7, 0xc7,
-	0xf1, 0x12, 0x94, 0xdb, 0xc9, 0xd5, 0xe2, 0x8e, 0x11, 0x7e, 0x07, 0x13, 0xdd, 0x19, 0xf0, 0xe8,
-	0x4a, 0x45, 0x41, 0xc8, 0xba, 0x3a, 0x5a, 0xe0, 0xea, 0x32, 0x54, 0x8b, 0x3d, 0x91, 0xce, 0x7e,
-	0x36, 0x0c, 0x17, 0x76, 0x63, 0x77, 0xa7, 0xd5, 0xdc, 0xbe, 0x7e, 0x13, 0xef, 0xfb, 0xe4, 0x00,
-	0x3b, 0xa7, 0xe7, 0xeb, 0x0a, 0x4c, 0x8a, 0xb8, 0xf1, 0x0a, 0xc5, 0xb3, 0xa9, 0xcc, 0x65, 0x37,
-	0x13, 0xd1, 0x49, 0xbd, 0xd5, 0xa1, 0x14, 0xa2, 0x20, 0x7d, 0x2e, 0xec, 0x37, 0x2b, 0x88, 0x07,
-	0x41, 0x9b, 0xf8, 0x22, 0x19, 0xc4, 0x4a, 0x37, 0xe0, 0x8c, 0x83, 0x6d, 0x2f, 0x40, 0x7e, 0xcc,
-	0x12, 0xa0, 0xd4, 0x92, 0xeb, 0x1c, 0x6b, 0x67, 0x0a, 0x58, 0x5b, 0x82, 0x4b, 0x85, 0x94, 0x48,
-	0xd2, 0x7e, 0xd7, 0x58, 0x07, 0x97, 0x8f, 0x73, 0xe7, 0x11, 0xb6, 0xbb, 0xf4, 0x34, 0x89, 0x2b,
-	0xa8, 0x5e, 0x09, 0x77, 0x93, 0x27, 0xac, 0x5e, 0xa5, 0x41, 0xd5, 0xeb, 0x24, 0x49, 0xc3, 0xc7,
-	0x83, 0x62, 0xe7, 0x24, 0x05, 0x3f, 0xf3, 0xbc, 0xe1, 0x1d, 0xf9, 0xed, 0x7d, 0x07, 0xfd, 0x2d,
-	0xf7, 0x7b, 0xec, 0x58, 0x5f, 0xa9, 0x2d, 0x73, 0x59, 0x31, 0x43, 0x23, 0x79, 0x86, 0xfe, 0x0f,
-	0xe3, 0x01, 0x0e, 0xda, 0x38, 0x8a, 0x2b, 0xa5, 0xe5, 0x91, 0x8d, 0xf2, 0xf6, 0x42, 0xed, 0x70,
-	0x08, 0xac, 0x35, 0x58, 0x83, 0xbd, 0x97, 0xce, 0x4d, 0xad, 0x54, 0x57, 0xdf, 0x83, 0xa9, 0x08,
-	0x3f, 0x44, 0x91, 0x63, 0x89, 0x0a, 0x36, 0xfa, 0x8f, 0x2a, 0xd8, 0x24, 0xbf, 0xe4, 0x06, 0xaf,
-	0x63, 0x2b, 0x20, 0xd6, 0x16, 0x4b, 0x5a, 0x91, 0x8e, 0x65, 0x2e, 0xbb, 0x9b, 0x88, 0x4e, 0x54,
-	0x98, 0x78, 0xde, 0xe5, 0x29, 0x95, 0xa4, 0xef, 0x81, 0x9e, 0xb4, 0x06, 0x14, 0xda, 0xd8, 0x3f,
-	0x1c, 0x77, 0x92, 0x17, 0x14, 0xa1, 0x30, 0x46, 0xb6, 0xda, 0xe8, 0x4a, 0xad, 0x29, 0x45, 0x7a,
-	0xdb, 0x51, 0xc6, 0x87, 0x61, 0x75, 0x7c, 0x30, 0x17, 0xc1, 0xc8, 0x5f, 0x2a, 0x4d, 0x7e, 0xa9,
-	0x31, 0x50, 0x7b, 0xdd, 0x76, 0xe0, 0xd1, 0x06, 0x72, 0xf6, 0xd2, 0x3e, 0xb5, 0xd3, 0xf3, 0x1c,
-	0x9c, 0xc4, 0xaa, 0x09, 0xe3, 0x71, 0xb7, 0xfd, 0x1e, 0xb6, 0x29, 0xb3, 0x5b, 0xde, 0x9e, 0xad,
-	0xf1, 0xa9, 0xb8, 0x96, 0x4e, 0xc5, 0xb5, 0x1b, 0xe1, 0x41, 0xe3, 0xfc, 0x8f, 0xdf, 0x6d, 0xce,
-	0xbc, 0xce, 0x23, 0x94, 0x9e, 0x6d, 0xa5, 0x27, 0xfb, 0x5b, 0xe2, 0x70, 0xa6, 0x25, 0x2a, 0xd0,
-	0x47, 0xfa, 0xa0, 0xaf, 0xc3, 0xea, 0x91, 0xd8, 0x52, 0x2f, 0xb6, 0x3f, 0x9a, 0x86, 0x91, 0xdd,
-	0xd8, 0xd5, 0x1f, 0xc2, 0x54, 0xff, 0x40, 0xbb, 0xa8, 0x26, 0x4d, 0x76, 0xc2, 0x34, 0xae, 0x1c,
-	0xb5, 0x2b, 0x29, 0x32, 0x3f, 0xfc, 0xe5, 0xcf, 0x2f, 0x86, 0x17, 0x4d, 0xa3, 0xae, 0xfc, 0x97,
-	0x20, 0x32, 0xdc, 0x16, 0x76, 0x3a, 0x30, 0x71, 0x18, 0xb0, 0x4a, 0xe6, 0x5a, 0xb9, 0x63, 0x2c,
-	0x0f, 0xda, 0x91, 0xc6, 0x96, 0x98, 0xb1, 0x79, 0xf3, 0xa2, 0x6a, 0x2c, 0xa1, 0xc3, 0xa2, 0xc4,
-	0xc2, 0xb4, 0xa3, 0xc7, 0x30, 0xd9, 0x37, 0x35, 0x2e, 0x64, 0xae, 0x54, 0x37, 0x8d, 0xcb, 0x47,
-	0x6c, 0x4a, 0x93, 0x2b, 0xcc, 0xe4, 0x82, 0x39, 0xaf, 0x9a, 0x8c, 0xb8, 0xa6, 0xc5, 0xfa, 0x56,
-	0x62, 0xb4, 0x6f, 0x9a, 0xcc, 0x1a, 0x55, 0x37, 0x73, 0x46, 0x0b, 0xc7, 0xba, 0x42, 0xa3, 0x82,
-	0x4d, 0x61, 0xf4, 0x31, 0x9c, 0xcd, 0x4d, 0x7d, 0x4b, 0xc5, 0x77, 0x4b, 0x05, 0x63, 0xfd, 0x18,
-	0x05, 0x09, 0x60, 0x99, 0x01, 0x30, 0xcc, 0x4a, 0x0e, 0x40, 0x60, 0xf9, 0x89, 0xb6, 0xfe, 0xb1,
-	0x06, 0xe7, 0xf2, 0x63, 0x58, 0x71, 0x08, 0x15, 0x0d, 0x63, 0xe3, 0x38, 0x0d, 0x89, 0x61, 0x83,
-	0x61, 0x30, 0xcd, 0xe5, 0xa2, 0x60, 0x8b, 0xc6, 0x6a, 0x33, 0xab, 0x9f, 0x6b, 0x70, 0xbe, 0x68,
-	0x60, 0x31, 0x33, 0xb6, 0x0a, 0x74, 0x8c, 0xff, 0x1c, 0xaf, 0x23, 0x11, 0x5d, 0x63, 0x88, 0x56,
-	0xcd, 0xcb, 0x2a, 0x22, 0x3e, 0xce, 0x28, 0x49, 0x28, 0x40, 0x3d, 0xd1, 0xe0, 0x9c, 0x5a, 0xcd,
-	0x38, 0xa4, 0x95, 0xc2, 0x47, 0xa5, 0xd6, 0x3b, 0xe3, 0xea, 0xb1, 0x2a, 0x47, 0x53, 0x24, 0x1e,
-	0x5f, 0x97, 0x1f, 0x10, 0x68, 0x3e, 0xd1, 0x40, 0x2f, 0x18, 0x73, 0xb2, 0x70, 0xf2, 0x2a, 0x39,
-	0x38, 0x47, 0x4c, 0x06, 0x85, 0x70, 0x70, 0x64, 0x6f, 0x5f, 0xb7, 0x1c, 0x71, 0x40, 0xc0, 0xf9,
-	0x5a, 0x83, 0xb9, 0x01, 0x03, 0xc4, 0x6a, 0xc6, 0x5e, 0xb1, 0x9a, 0xb1, 0x79, 0x22, 0x35, 0x09,
-	0x6d, 0x93, 0x41, 0x5b, 0x37, 0x57, 0x55, 0x68, 0x2c, 0x93, 0x2d, 0x1b, 0xf9, 0xbe, 0x85, 0xc5,
-	0x29, 0x81, 0xef, 0x2b, 0x0d, 0xe6, 0x06, 0x7c, 0xa2, 0x58, 0xcd, 0x25, 0x70, 0x91, 0x5a, 0x0e,
-	0xdf, 0x31, 0x1f, 0x1c, 0xfe, 0xcb, 0xf0, 0xad, 0x99, 0x57, 0xfa, 0x93, 0x9d, 0x5a, 0x6a, 0x8f,
-	0x4c, 0x3f, 0x20, 0xe8, 0x1f, 0x68, 0x30, 0x93, 0x6d, 0x84, 0xd5, 0xec, 0xdb, 0xee, 0xdf, 0x37,
-	0xd6, 0x8e, 0xde, 0x97, 0x48, 0xd6, 0x18, 0x92, 0x65, 0xb3, 0xda, 0xf7, 0xf4, 0x99, 0xb2, 0x9a,
-	0xe5, 0xfa, 0x37, 0x1a, 0x18, 0x47, 0x34, 0xc6, 0x6c, 0xda, 0x0c, 0x56, 0x35, 0xb6, 0x4e, 0xac,
-	0x2a, 0x41, 0x6e, 0x31, 0x90, 0xd7, 0xcc, 0xab, 0x7d, 0x74, 0xb1, 0x73, 0x56, 0x1b, 0x39, 0x96,
-	0xec, 0x9e, 0x16, 0x16, 0x47, 0x1b, 0xef, 0x3e, 0x7d, 0x51, 0xd5, 0x9e, 0xbd, 0xa8, 0x6a, 0x7f,
-	0xbc, 0xa8, 0x6a, 0x9f, 0xbe, 0xac, 0x0e, 0x3d, 0x7b, 0x59, 0x1d, 0xfa, 0xf5, 0x65, 0x75, 0xe8,
-	0x9d, 0x86, 0x32, 0xf6, 0x20, 0x9f, 0x76, 0x30, 0xda, 0x0c, 0x31, 0x4d, 0x47, 0x1f, 0x61, 0x60,
-	0x93, 0x7f, 0xb5, 0xa8, 0x07, 0xc4, 0xe9, 0xfa, 0xb8, 0xfe, 0x48, 0x1a, 0x66, 0x63, 0x51, 0x7b,
-	0x8c, 0xb5, 0xfb, 0xff, 0xfd, 0x15, 0x00, 0x00, 0xff, 0xff, 0x3b, 0x97, 0xcb, 0x92, 0xa3, 0x13,
-	0x00, 0x00,
+	// 1793 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0x23, 0x49,
+	0x15, 0x4f, 0xdb, 0x4e, 0x32, 0x79, 0x76, 0x92, 0x49, 0x27, 0x93, 0x38, 0x9d, 0x8c, 0xe3, 0x74,
+	0x36, 0x5f, 0xbb, 0xd8, 0xde, 0x04, 0xd0, 0xde, 0x58, 0x8d, 0x33, 0x59, 0xed, 0x48, 0x64, 0x91,
+	0x9c, 0x61, 0x0e, 0x08, 0xa9, 0x55, 0xee, 0xae, 0xe9, 0x6e, 0xa6, 0x3f, 0x42, 0x57, 0xd9, 0xbb,
+	0xb9, 0xac, 0x04, 0x9c, 0xd0, 0x70, 0x58, 0xe0, 0x84, 0x04, 0x7f, 0x02, 0xe2, 0xb2, 0x5c, 0xb8,
+	0x70, 0x1d, 0xed, 0x01, 0x2d, 0xe2, 0x82, 0x40, 0x1a, 0xa1, 0x19, 0xfe, 0x10, 0xd4, 0x55, 0xd5,
+	0x95, 0xee, 0x76, 0xdb, 0x31, 0x28, 0x9c, 0xe2, 0x7e, 0xef, 0xd5, 0x7b, 0xbf, 0xf7, 0x59, 0xaf,
+	0x02, 0x0f, 0xec, 0x08, 0x0d, 0x5d, 0x7a, 0xdd, 0x19, 0x9e, 0x74, 0x7c, 0x62, 0x93, 0xf6, 0x55,
+	0x14, 0xd2, 0x50, 0x05, 0x41, 0x6e, 0x0f, 0x4f, 0xb4, 0x86, 0x19, 0x12, 0x3f, 0x24, 0x9d, 0x3e,
+	0x22, 0xb8, 0x33, 0x3c, 0xe9, 0x63, 0x8a, 0x4e, 0x3a, 0x66, 0xe8, 0x06, 0x5c, 0x56, 0x5b, 0xb3,
+	0x43, 0x3b, 0x64, 0x3f, 0x3b, 0xf1, 0x2f, 0x41, 0xdd, 0xb6, 0xc3, 0xd0, 0xf6, 0x70, 0x07, 0x5d,
+	0xb9, 0x1d, 0x14, 0x04, 0x21, 0x45, 0xd4, 0x0d, 0x03, 0xa1, 0x5f, 0x5b, 0x4f, 0x99, 0xa5, 0xd7,
+	0x57, 0x98, 0xc8, 0x53, 0x37, 0x74, 0x44, 0x29, 0x26, 0xfc, 0x98, 0xe0, 0x6e, 0x0a, 0x9d, 0xec,
+	0xab, 0x3f, 0x78, 0xde, 0x41, 0xc1, 0x75, 0xc2, 0xe2, 0x20, 0x0d, 0x8e, 0x83, 0x7f, 0x70, 0x96,
+	0xfe, 0x39, 0x6c, 0x5e, 0x10, 0xfb, 0x12, 0xd3, 0xef, 0x45, 0xa6, 0x83, 0x09, 0x8d, 0x10, 0x0d,
+	0xa3, 0x47, 0x96, 0x15, 0x61, 0x42, 0xd4, 0x6d, 0x58, 0x18, 0x22, 0xcf, 0xb5, 0x62, 0x5a, 0x5d,
+	0x69, 0x2a, 0x47, 0x0b, 0xbd, 0x1b, 0x82, 0xaa, 0x43, 0x2d, 0x4c, 0x1d, 0xaa, 0x97, 0x98, 0x40,
+	0x86, 0xa6, 0xee, 0x40, 0x15, 0x53, 0xc7, 0x40, 0x5c, 0x61, 0xbd, 0xcc, 0x44, 0x00, 0x53, 0x47,
+	0x98, 0xd0, 0xf7, 0x60, 0x77, 0xac, 0xfd, 0x1e, 0x26, 0x57, 0x61, 0x40, 0xb0, 0xfe, 0x52, 0x81,
+	0xfb, 0x17, 0xc4, 0x7e, 0x86, 0x3c, 0x82, 0xe9, 0x59, 0x18, 0x3c, 0x77, 0x23, 0x5f, 0x5d, 0x83,
+	0xd9, 0x20, 0x0c, 0x4c, 0xcc, 0x80, 0x55, 0x7a, 0xfc, 0xe3, 0x4e, 0x40, 0xc5, 0x7e, 0x13, 0xd7,
+	0x0e, 0x10, 0x1d, 0x44, 0xb8, 0x5e, 0xe1, 0x7e, 0x4b, 0x82, 0xae, 0x41, 0x3d, 0x0f, 0x46, 0x22,
+	0xfd, 0x93, 0x02, 0x35, 0xe6, 0x4f, 0x60, 0x3d, 0x0d, 0xcf, 0xa9, 0xa3, 0xae, 0xc3, 0x1c, 0xc1,
+	0x81, 0x85, 0x93, 0xf8, 0x89, 0x2f, 0x75, 0x13, 0xee, 0xc5, 0x18, 0x2c, 0x4c, 0xa8, 0xc0, 0x38,
+	0x8f, 0xa9, 0xf3, 0x18, 0x13, 0xaa, 0x7e, 0x00, 0x73, 0xc8, 0x0f, 0x07, 0x01, 0x65, 0xc8, 0xaa,
+	0xa7, 0x9b, 0x6d, 0x91, 0xb1, 0xb8, 0xc6, 0xda, 0xa2, 0xc6, 0xda, 0x67, 0xa1, 0x1b, 0x74, 0x2b,
+	0xaf, 0x5e, 0xef, 0xcc, 0xf4, 0x84, 0xb8, 0xfa, 0x1d, 0x80, 0x7e, 0xe4, 0x5a, 0x36, 0x36, 0x9e,
+	0x63, 0x8e, 0x7b, 0x8a, 0xc3, 0x0b, 0xfc, 0xc8, 0x47, 0x18, 0xeb, 0xeb, 0xb0, 0x96, 0xc6, 0x2e,
+	0x9d, 0xfa, 0x10, 0x96, 0x2f, 0x88, 0xdd, 0xc3, 0x3f, 0x1e, 0x60, 0x42, 0xbb, 0x88, 0x9a, 0xe3,
+	0xdd, 0x5a, 0x83, 0x59, 0x0b, 0x07, 0xa1, 0x2f, 0x7c, 0xe2, 0x1f, 0xfa, 0x26, 0x6c, 0xe4, 0x14,
+	0x48, 0xdd, 0x7f, 0x50, 0x98, 0x72, 0x11, 0x47, 0xae, 0xbc, 0x38, 0xb3, 0xfb, 0xb0, 0x44, 0xc3,
+	0x17, 0x38, 0x30, 0xcc, 0x30, 0xa0, 0x11, 0x32, 0x93, 0xb8, 0x2d, 0x32, 0xea, 0x99, 0x20, 0xaa,
+	0x0f, 0x21, 0xce, 0xa4, 0x11, 0xa7, 0x0b, 0x47, 0x22, 0xb7, 0x0b, 0x98, 0x3a, 0x97, 0x8c, 0x30,
+	0x52, 0x1f, 0x95, 0x82, 0xfa, 0xc8, 0xa4, 0x7f, 0x36, 0x9f, 0x7e, 0xee, 0x4c, 0x1a, 0xb0, 0x74,
+	0xe6, 0x2f, 0x0a, 0xac, 0xde, 0xf0, 0xbe, 0x1b, 0xda, 0xae, 0x79, 0x86, 0x3c, 0x4f, 0x3d, 0x84,
+	0x65, 0x37, 0x10, 0x8d, 0xe3, 0x86, 0x81, 0xe1, 0x5a, 0x22, 0x6c, 0x4b, 0x69, 0xf2, 0x13, 0x4b,
+	0x6d, 0x81, 0x9a, 0x11, 0xe4, 0x61, 0x28, 0xb1, 0x30, 0xac, 0xa4, 0x39, 0x9f, 0xb0, 0x90, 0xfc,
+	0xdf, 0x7d, 0x7d, 0x08, 0x5b, 0x05, 0xfe, 0x48, 0x7f, 0xff, 0x5c, 0x4a, 0x55, 0xcc, 0x19, 0xab,
+	0xb3, 0x33, 0x0f, 0xb9, 0x3e, 0xeb, 0xb0, 0x21, 0x0e, 0xa8, 0x91, 0xce, 0x23, 0x30, 0x12, 0x47,
+	0xbe, 0x0b, 0xb5, 0xbe, 0x17, 0x9a, 0x2f, 0x0c, 0x07, 0xbb, 0xb6, 0x43, 0x85, 0x8b, 0x55, 0x46,
+	0xfb, 0x98, 0x91, 0x0a, 0xf2, 0x5d, 0x2e, 0xca, 0xf7, 0x47, 0xb2, 0x5b, 0x98, 0x7b, 0xdd, 0x76,
+	0x5c, 0xd5, 0xff, 0x78, 0xbd, 0x73, 0x60, 0xbb, 0xd4, 0x19, 0xf4, 0xdb, 0x66, 0xe8, 0x8b, 0x89,
+	0x27, 0xfe, 0xb4, 0x88, 0xf5, 0x42, 0x8c, 0xd5, 0x27, 0x01, 0x95, 0xcd, 0x73, 0x08, 0xcb, 0x98,
+	0x3a, 0x38, 0xc2, 0x03, 0xdf, 0x10, 0xa5, 0xcd, 0xc3, 0xb1, 0x94, 0x90, 0x2f, 0x79, 0x89, 0x1f,
+	0xc2, 0xb2, 0x18, 0xa7, 0x11, 0x36, 0xb1, 0x3b, 0xc4, 0x51, 0x7d, 0x8e, 0x0b, 0x72, 0x72, 0x4f,
+	0x50, 0x47, 0xc2, 0x3f, 0x3f, 0x1a, 0x7e, 0xbd, 0x01, 0xdb, 0x45, 0x01, 0x94, 0x11, 0x7e, 0xa5,
+	0xc0, 0xfa, 0x05, 0xb1, 0x59, 0x99, 0xc9, 0xc6, 0xbc, 0xbb, 0x18, 0xef, 0x40, 0xb5, 0x1f, 0xab,
+	0x16, 0x3a, 0xca, 0x5c, 0x07, 0x23, 0x7d, 0x32, 0xa6, 0xe9, 0x2a, 0x45, 0x49, 0xc8, 0xbb, 0x3a,
+	0x5b, 0xe0, 0x6a, 0x13, 0x1a, 0xc5, 0x9e, 0x48, 0x67, 0x7f, 0x59, 0x82, 0x07, 0x17, 0xc4, 0x3e,
+	0xef, 0x9d, 0x9d, 0xbe, 0xff, 0x18, 0x5f, 0x79, 0xe1, 0x35, 0xb6, 0xee, 0xce, 0xd7, 0x5d, 0xa8,
+	0x89, 0xbc, 0xf1, 0x09, 0xc5, 0xab, 0xa9, 0xca, 0x69, 0x8f, 0x63, 0xd2, 0xb4, 0xde, 0xaa, 0x50,
+	0x09, 0x90, 0x9f, 0xb4, 0x0b, 0xfb, 0xcd, 0x06, 0xe2, 0xb5, 0xdf, 0x0f, 0x3d, 0x51, 0x0c, 0xe2,
+	0x4b, 0xd5, 0xe0, 0x9e, 0x85, 0x4d, 0xd7, 0x47, 0x1e, 0x61, 0x05, 0x50, 0xe9, 0xc9, 0xef, 0x91,
+	0xa8, 0xdd, 0x2b, 0x88, 0xda, 0x0e, 0x3c, 0x2c, 0x0c, 0x89, 0x0c, 0xda, 0x3f, 0x15, 0x76, 0x83,
+	0xcb, 0xe6, 0x3c, 0xff, 0x0c, 0x9b, 0x03, 0x7a, 0x97, 0x81, 0x2b, 0x98, 0x5e, 0x71, 0xec, 0x6a,
+	0x53, 0x4e, 0xaf, 0xca, 0xb8, 0xe9, 0x35, 0x4d, 0xd1, 0xf0, 0xf5, 0xa0, 0xd8, 0x39, 0x19, 0x82,
+	0xbf, 0xf2, 0xba, 0xe1, 0x37, 0xf2, 0xf7, 0xaf, 0x2c, 0xf4, 0x5f, 0xb9, 0x3f, 0x64, 0xc7, 0x32,
+	0xa3, 0xb6, 0xca, 0x69, 0xc5, 0x11, 0x2a, 0x8f, 0x46, 0xe8, 0xdb, 0x30, 0xef, 0x63, 0xbf, 0x8f,
+	0x23, 0x52, 0xaf, 0x34, 0xcb, 0x47, 0xd5, 0xd3, 0xad, 0xf6, 0xcd, 0x8a, 0xd8, 0xee, 0xb2, 0x0b,
+	0xf6, 0x59, 0xb2, 0x37, 0xf5, 0x12, 0x59, 0xf5, 0x12, 0x16, 0x23, 0xfc, 0x29, 0x8a, 0x2c, 0x43,
+	0x4c, 0xb0, 0xd9, 0xff, 0x69, 0x82, 0xd5, 0xb8, 0x92, 0x47, 0x7c, 0x8e, 0xed, 0x82, 0xf8, 0x36,
+	0x58, 0xd1, 0x8a, 0x72, 0xac, 0x72, 0xda, 0xd3, 0x98, 0x34, 0xd5, 0x60, 0xe2, 0x75, 0x37, 0x1a,
+	0x52, 0x19, 0xf4, 0x4b, 0x50, 0xe3, 0xab, 0x01, 0x05, 0x26, 0xf6, 0x6e, 0xd6, 0x9d, 0xb8, 0x83,
+	0x22, 0x14, 0x10, 0x64, 0xa6, 0x2f, 0xba, 0x4a, 0x6f, 0x31, 0x45, 0x7d, 0x62, 0xa5, 0xd6, 0x87,
+	0x52, 0x7a, 0x7d, 0xd0, 0xb7, 0x41, 0x1b, 0x55, 0x2a, 0x4d, 0xfe, 0x46, 0x61, 0xa0, 0x2e, 0x07,
+	0x7d, 0xdf, 0xa5, 0x5d, 0x64, 0x5d, 0x26, 0xf7, 0xd4, 0xf9, 0xd0, 0xb5, 0x70, 0x9c, 0xab, 0x2e,
+	0xcc, 0x93, 0x41, 0xff, 0x47, 0xd8, 0xa4, 0xcc, 0x6e, 0xf5, 0x74, 0xad, 0xcd, 0xb7, 0xe2, 0x76,
+	0xb2, 0x15, 0xb7, 0x1f, 0x05, 0xd7, 0x5d, 0xf5, 0xab, 0x2f, 0x5b, 0x4b, 0xe7, 0xc9, 0x58, 0x8f,
+	0x2f, 0x4b, 0xab, 0x97, 0x1c, 0xcc, 0xde, 0x88, 0xa5, 0xdc, 0x8d, 0x98, 0x42, 0x5e, 0xce, 0x20,
+	0x3f, 0x84, 0xfd, 0x89, 0xd0, 0xa4, 0x13, 0x7f, 0xe4, 0x13, 0x3d, 0xb1, 0xce, 0x2b, 0x88, 0x57,
+	0xeb, 0x2e, 0xd4, 0x78, 0x8d, 0x65, 0xca, 0xb5, 0xca, 0x69, 0x53, 0xb7, 0x6b, 0x3e, 0xbb, 0xe5,
+	0x82, 0x5b, 0xff, 0x04, 0xd6, 0xd2, 0xdf, 0xc6, 0x10, 0x47, 0xc4, 0x0d, 0x03, 0x31, 0xee, 0x56,
+	0xd3, 0xbc, 0x67, 0x9c, 0x25, 0xc6, 0x77, 0x01, 0x6c, 0xe9, 0xd9, 0x10, 0x96, 0x65, 0x08, 0x18,
+	0x67, 0x74, 0xc2, 0x29, 0x05, 0x58, 0x3e, 0x84, 0x39, 0x93, 0x49, 0xd7, 0x4b, 0xcd, 0xf2, 0xd8,
+	0x94, 0xad, 0x7c, 0xf5, 0x65, 0x6b, 0x31, 0xb1, 0xce, 0xed, 0x8a, 0x63, 0xfa, 0xcf, 0x14, 0xa8,
+	0x26, 0x48, 0x06, 0x1e, 0xbd, 0xbd, 0xe9, 0xbf, 0x05, 0xc0, 0x8e, 0x1a, 0x71, 0x0f, 0xb1, 0x10,
+	0x2e, 0x9d, 0x3e, 0x48, 0x77, 0x2c, 0xd3, 0xf6, 0xf4, 0xfa, 0x0a, 0xf7, 0x16, 0xcc, 0xe4, 0x67,
+	0xbc, 0x6c, 0xf1, 0x53, 0x0e, 0x22, 0x8e, 0x98, 0x80, 0x9c, 0xfd, 0x31, 0x22, 0x8e, 0xde, 0x83,
+	0x8d, 0x9c, 0xf7, 0x49, 0x60, 0xd4, 0x0f, 0x60, 0x3e, 0x62, 0xd0, 0x48, 0x5d, 0x61, 0x2e, 0x6e,
+	0x8c, 0x18, 0xe3, 0xd0, 0xc5, 0x4a, 0x9e, 0x48, 0x9f, 0xbe, 0xbc, 0x0f, 0xe5, 0x0b, 0x62, 0xab,
+	0x9f, 0xc2, 0x62, 0xf6, 0xed, 0xb3, 0x9d, 0x56, 0x90, 0x7f, 0x8c, 0x68, 0xef, 0x4c, 0xe2, 0xca,
+	0x74, 0xe9, 0x3f, 0xfd, 0xdb, 0xbf, 0x7f, 0x5d, 0xda, 0xd6, 0xb5, 0x4e, 0xea, 0x59, 0x29, 0x86,
+	0xa1, 0x29, 0xec, 0x38, 0xb0, 0x70, 0xd3, 0xdb, 0xf5, 0x9c, 0x5a, 0xc9, 0xd1, 0x9a, 0xe3, 0x38,
+	0xd2, 0xd8, 0x0e, 0x33, 0xb6, 0xa9, 0x6f, 0xa4, 0x8d, 0xc5, 0xad, 0x63, 0xd0, 0xd0, 0xc0, 0xd4,
+	0x51, 0x09, 0xd4, 0x32, 0x0f, 0x8c, 0xad, 0x9c, 0xca, 0x34, 0x53, 0xdb, 0x9b, 0xc0, 0x94, 0x26,
+	0x77, 0x99, 0xc9, 0x2d, 0x7d, 0x33, 0x6d, 0x32, 0xe2, 0x92, 0x06, 0x5b, 0x71, 0x62, 0xa3, 0x99,
+	0x87, 0x47, 0xde, 0x68, 0x9a, 0xa9, 0xed, 0x4d, 0x60, 0x4e, 0x36, 0x2a, 0xa2, 0x29, 0x8c, 0x7e,
+	0x0e, 0xf7, 0x47, 0x1e, 0x08, 0x3b, 0xc5, 0xba, 0xa5, 0x80, 0x76, 0x78, 0x8b, 0x80, 0x04, 0xd0,
+	0x64, 0x00, 0x34, 0xbd, 0x3e, 0x02, 0xc0, 0x37, 0xbc, 0x58, 0x5a, 0xfd, 0xb9, 0x02, 0x2b, 0xa3,
+	0x1b, 0x7b, 0x71, 0x0a, 0x53, 0x12, 0xda, 0xd1, 0x6d, 0x12, 0x12, 0xc3, 0x11, 0xc3, 0xa0, 0xeb,
+	0xcd, 0xa2, 0x64, 0x8b, 0x1d, 0x8c, 0x35, 0x8e, 0xfa, 0x2b, 0x05, 0x56, 0x8b, 0x76, 0x5b, 0x3d,
+	0x67, 0xab, 0x40, 0x46, 0x7b, 0xf7, 0x76, 0x19, 0x89, 0xe8, 0x3d, 0x86, 0x68, 0x5f, 0xdf, 0x4b,
+	0x23, 0xe2, 0x9b, 0x6f, 0xaa, 0x08, 0x05, 0xa8, 0x97, 0x0a, 0xac, 0xa4, 0x2f, 0x3e, 0x31, 0x9c,
+	0x0b, 0x9b, 0x2a, 0x7d, 0x35, 0x6a, 0xc7, 0xb7, 0x8a, 0x4c, 0x0e, 0x91, 0x68, 0xbe, 0x01, 0x3f,
+	0x20, 0xd0, 0xfc, 0x42, 0x01, 0xb5, 0x60, 0x23, 0xce, 0xc3, 0x19, 0x15, 0xd1, 0x8e, 0x6f, 0x15,
+	0x99, 0x0c, 0x07, 0x47, 0xe6, 0xe9, 0xfb, 0x86, 0x25, 0x0e, 0x08, 0x38, 0xbf, 0x53, 0x60, 0x7d,
+	0xcc, 0xae, 0xb9, 0x9f, 0xb3, 0x57, 0x2c, 0xa6, 0xb5, 0xa6, 0x12, 0x93, 0xd0, 0x5a, 0x0c, 0xda,
+	0xa1, 0xbe, 0x9f, 0x86, 0xc6, 0x2a, 0xd9, 0x30, 0x91, 0xe7, 0x19, 0x58, 0x9c, 0x12, 0xf8, 0x7e,
+	0xab, 0xc0, 0xfa, 0x98, 0xff, 0x66, 0xed, 0x8f, 0x14, 0x70, 0x91, 0x98, 0xd6, 0x9a, 0x4a, 0x4c,
+	0xe2, 0xfb, 0x06, 0xc3, 0x77, 0xa0, 0xbf, 0x93, 0x2d, 0x76, 0x6a, 0x64, 0x2e, 0x58, 0xf1, 0xbf,
+	0x26, 0xf5, 0x27, 0x0a, 0x2c, 0xe7, 0x77, 0xa6, 0x46, 0xbe, 0xb7, 0xb3, 0x7c, 0xed, 0x60, 0x32,
+	0x5f, 0x22, 0x39, 0x60, 0x48, 0x9a, 0x7a, 0x23, 0xd3, 0xfa, 0x4c, 0x38, 0x5d, 0xe5, 0xea, 0xef,
+	0x15, 0xd0, 0x26, 0xec, 0x50, 0xf9, 0xb2, 0x19, 0x2f, 0xaa, 0x9d, 0x4c, 0x2d, 0x2a, 0x41, 0x9e,
+	0x30, 0x90, 0xef, 0xe9, 0xc7, 0x99, 0x70, 0xb1, 0x73, 0x46, 0x1f, 0x59, 0x86, 0xdc, 0xb4, 0x0c,
+	0x9c, 0x00, 0xfa, 0x42, 0x81, 0xd5, 0xa2, 0x75, 0x29, 0x3f, 0x24, 0x0a, 0x64, 0xb4, 0x77, 0x6f,
+	0x97, 0x91, 0xd0, 0x8e, 0x19, 0xb4, 0x3d, 0x7d, 0x37, 0xd3, 0x04, 0xe2, 0x80, 0x58, 0xb8, 0x44,
+	0x95, 0x11, 0xa8, 0x65, 0xf6, 0x9c, 0xad, 0xc2, 0x40, 0x70, 0xa6, 0xb6, 0x37, 0x81, 0x39, 0xf9,
+	0xe2, 0x10, 0x71, 0x61, 0x36, 0x49, 0xf7, 0x87, 0xaf, 0xde, 0x34, 0x94, 0xaf, 0xdf, 0x34, 0x94,
+	0x7f, 0xbd, 0x69, 0x28, 0x5f, 0xbc, 0x6d, 0xcc, 0x7c, 0xfd, 0xb6, 0x31, 0xf3, 0xf7, 0xb7, 0x8d,
+	0x99, 0x1f, 0x74, 0x53, 0x2f, 0x05, 0xe4, 0x51, 0x07, 0xa3, 0x56, 0x80, 0x69, 0xf2, 0x5a, 0x10,
+	0x0a, 0x5b, 0xfc, 0x1f, 0x7d, 0x1d, 0x3f, 0xb4, 0x06, 0x1e, 0xee, 0x7c, 0x26, 0x0d, 0xb1, 0x97,
+	0x44, 0x7f, 0x8e, 0xad, 0x5b, 0xdf, 0xfc, 0xcf, 0x00, 0xcc, 0xe6, 0xa0, 0xde, 0xf4, 0x16, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1680,6 +1980,8 @@ type MsgClient interface {
 	SetOrchestratorAddress(ctx context.Context, in *MsgSetOrchestratorAddress, opts ...grpc.CallOption) (*MsgSetOrchestratorAddressResponse, error)
 	CancelSendToEth(ctx context.Context, in *MsgCancelSendToEth, opts ...grpc.CallOption) (*MsgCancelSendToEthResponse, error)
 	SubmitBadSignatureEvidence(ctx context.Context, in *MsgSubmitBadSignatureEvidence, opts ...grpc.CallOption) (*MsgSubmitBadSignatureEvidenceResponse, error)
+	EthereumHeightClaim(ctx context.Context, in *MsgEthereumHeightClaim, opts ...grpc.CallOption) (*MsgEthereumHeightClaimResponse, error)
+	SubmitClaims(ctx context.Context, in *MsgSubmitClaims, opts ...grpc.CallOption) (*MsgSubmitClaimsResponse, error)
 }
 
 type msgClient struct {
@@ -1807,6 +2109,24 @@ func (c *msgClient) SubmitBadSignatureEvidence(ctx context.Context, in *MsgSubmi
 	return out, nil
 }
 
+func (c *msgClient) EthereumHeightClaim(ctx context.Context, in *MsgEthereumHeightClaim, opts ...grpc.CallOption) (*MsgEthereumHeightClaimResponse, error) {
+	out := new(MsgEthereumHeightClaimResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/EthereumHeightClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitClaims(ctx context.Context, in *MsgSubmitClaims, opts ...grpc.CallOption) (*MsgSubmitClaimsResponse, error) {
+	out := new(MsgSubmitClaimsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SubmitClaims", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	ValsetConfirm(context.Context, *MsgValsetConfirm) (*MsgValsetConfirmResponse, error)
@@ -1822,6 +2142,8 @@ type MsgServer interface {
 	SetOrchestratorAddress(context.Context, *MsgSetOrchestratorAddress) (*MsgSetOrchestratorAddressResponse, error)
 	CancelSendToEth(context.Context, *MsgCancelSendToEth) (*MsgCancelSendToEthResponse, error)
 	SubmitBadSignatureEvidence(context.Context, *MsgSubmitBadSignatureEvidence) (*MsgSubmitBadSignatureEvidenceResponse, error)
+	EthereumHeightClaim(context.Context, *MsgEthereumHeightClaim) (*MsgEthereumHeightClaimResponse, error)
+	SubmitClaims(context.Context, *MsgSubmitClaims) (*MsgSubmitClaimsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1867,6 +2189,12 @@ func (*UnimplementedMsgServer) CancelSendToEth(ctx context.Context, req *MsgCanc
 func (*UnimplementedMsgServer) SubmitBadSignatureEvidence(ctx context.Context, req *MsgSubmitBadSignatureEvidence) (*MsgSubmitBadSignatureEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitBadSignatureEvidence not implemented")
 }
+func (*UnimplementedMsgServer) EthereumHeightClaim(ctx context.Context, req *MsgEthereumHeightClaim) (*MsgEthereumHeightClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EthereumHeightClaim not implemented")
+}
+func (*UnimplementedMsgServer) SubmitClaims(ctx context.Context, req *MsgSubmitClaims) (*MsgSubmitClaimsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitClaims not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -2106,6 +2434,42 @@ func _Msg_SubmitBadSignatureEvidence_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_EthereumHeightClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEthereumHeightClaim)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EthereumHeightClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/EthereumHeightClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EthereumHeightClaim(ctx, req.(*MsgEthereumHeightClaim))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitClaims_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitClaims)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitClaims(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/SubmitClaims",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitClaims(ctx, req.(*MsgSubmitClaims))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "gravity.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -2162,6 +2526,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitBadSignatureEvidence",
 			Handler:    _Msg_SubmitBadSignatureEvidence_Handler,
 		},
+		{
+			MethodName: "EthereumHeightClaim",
+			Handler:    _Msg_EthereumHeightClaim_Handler,
+		},
+		{
+			MethodName: "SubmitClaims",
+			Handler:    _Msg_SubmitClaims_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gravity/v1/msgs.proto",
@@ -3173,43 +3545,234 @@ func (m *MsgSubmitBadSignatureEvidenceResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMsgs(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgEthereumHeightClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSetOrchestratorAddress) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgEthereumHeightClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEthereumHeightClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.OrchestratorVersion) > 0 {
+		i -= len(m.OrchestratorVersion)
+		copy(dAtA[i:], m.OrchestratorVersion)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.OrchestratorVersion)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Orchestrator)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.Orchestrator) > 0 {
+		i -= len(m.Orchestrator)
+		copy(dAtA[i:], m.Orchestrator)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Orchestrator)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.EthAddress)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if m.BlockHeight != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.HeightNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.HeightNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetOrchestratorAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+func (m *MsgEthereumHeightClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEthereumHeightClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEthereumHeightClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitClaims) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitClaims) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitClaims) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claims) > 0 {
+		for iNdEx := len(m.Claims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Orchestrator) > 0 {
+		i -= len(m.Orchestrator)
+		copy(dAtA[i:], m.Orchestrator)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Orchestrator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClaimHash) > 0 {
+		i -= len(m.ClaimHash)
+		copy(dAtA[i:], m.ClaimHash)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ClaimHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ClaimType != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.ClaimType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitClaimsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitClaimsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitClaimsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgs(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetOrchestratorAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Orchestrator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EthAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetOrchestratorAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	return n
 }
@@ -3634,6 +4197,91 @@ func (m *MsgSubmitBadSignatureEvidenceResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgEthereumHeightClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HeightNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.HeightNonce))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovMsgs(uint64(m.BlockHeight))
+	}
+	l = len(m.Orchestrator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.OrchestratorVersion)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEthereumHeightClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitClaims) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Orchestrator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if len(m.Claims) > 0 {
+		for _, e := range m.Claims {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClaimResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.EventNonce))
+	}
+	if m.ClaimType != 0 {
+		n += 1 + sovMsgs(uint64(m.ClaimType))
+	}
+	l = len(m.ClaimHash)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitClaimsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovMsgs(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6718,6 +7366,545 @@ func (m *MsgSubmitBadSignatureEvidenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgEthereumHeightClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEthereumHeightClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEthereumHeightClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeightNonce", wireType)
+			}
+			m.HeightNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeightNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrchestratorVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEthereumHeightClaimResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEthereumHeightClaimResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEthereumHeightClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitClaims) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitClaims: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitClaims: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claims = append(m.Claims, &types1.Any{})
+			if err := m.Claims[len(m.Claims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimType", wireType)
+			}
+			m.ClaimType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimType |= ClaimType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimHash = append(m.ClaimHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClaimHash == nil {
+				m.ClaimHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitClaimsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitClaimsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitClaimsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, ClaimResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMsgs(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,44 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeUnsuspendToken defines the type for an UnsuspendTokenProposal
+	ProposalTypeUnsuspendToken = "UnsuspendToken"
+)
+
+var _ govtypes.Content = &UnsuspendTokenProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeUnsuspendToken)
+	govtypes.RegisterProposalTypeCodec(&UnsuspendTokenProposal{}, "gravity/UnsuspendTokenProposal")
+}
+
+// NewUnsuspendTokenProposal returns a new governance proposal lifting the suspension of a token
+func NewUnsuspendTokenProposal(title, description, tokenContract string) *UnsuspendTokenProposal {
+	return &UnsuspendTokenProposal{
+		Title:         title,
+		Description:   description,
+		TokenContract: tokenContract,
+	}
+}
+
+// ProposalRoute returns the routing key of the proposal
+func (p *UnsuspendTokenProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *UnsuspendTokenProposal) ProposalType() string { return ProposalTypeUnsuspendToken }
+
+// ValidateBasic performs stateless checks
+func (p *UnsuspendTokenProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := ValidateEthAddress(p.TokenContract); err != nil {
+		return sdkerrors.Wrap(err, "token contract")
+	}
+	return nil
+}
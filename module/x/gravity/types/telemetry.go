@@ -0,0 +1,28 @@
+package types
+
+// Telemetry metric keys, every metric is emitted under the module name, e.g. gravity_pool_size
+const (
+	// gauges, set in the EndBlocker
+	MetricKeyPoolSize               = "pool_size"
+	MetricKeyPoolFees               = "pool_fees"
+	MetricKeyOutstandingBatches     = "outstanding_batches"
+	MetricKeyOutstandingLogicCalls  = "outstanding_logic_calls"
+	MetricKeyLastObservedEventNonce = "last_observed_event_nonce"
+	MetricKeyLastObservedEthHeight  = "last_observed_ethereum_height"
+	MetricKeyValidatorEventNonceLag = "validator_event_nonce_lag"
+
+	// counters
+	MetricKeyDeposits            = "deposits"
+	MetricKeyDepositsForwarded   = "deposits_forwarded"
+	MetricKeyWithdrawals         = "withdrawals"
+	MetricKeyBatchTimeouts       = "batch_timeouts"
+	MetricKeyAttestationFailures = "attestation_failures"
+	MetricKeySlashes             = "slashes"
+	MetricKeyEvidenceSubmissions = "evidence_submissions"
+
+	MetricLabelTokenContract = "token_contract"
+	MetricLabelValidator     = "validator"
+	MetricLabelClaimType     = "claim_type"
+	MetricLabelReason        = "reason"
+	MetricLabelChannel       = "channel"
+)
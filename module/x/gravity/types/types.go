@@ -156,6 +156,11 @@ func NewValset(nonce, height uint64, members BridgeValidators, rewardAmount sdk.
 	return &Valset{Nonce: uint64(nonce), Members: mem, Height: height, RewardAmount: rewardAmount, RewardToken: rewardToken}
 }
 
+// GetSignedNonce returns the valset nonce
+func (v Valset) GetSignedNonce() []byte {
+	return append([]byte{byte(CONFIRM_TYPE_VALSET)}, UInt64Bytes(v.Nonce)...)
+}
+
 // GetCheckpoint returns the checkpoint
 func (v Valset) GetCheckpoint(gravityIDstring string) []byte {
 
@@ -264,6 +269,9 @@ func (b OutgoingTxBatch) GetFees() sdk.Int {
 // The naming here could be improved.
 type EthereumSigned interface {
 	GetCheckpoint(gravityIDstring string) []byte
+	// GetSignedNonce identifies the nonce the contract accepts the signed object for, a fake object signed for
+	// the same nonce returns the same bytes
+	GetSignedNonce() []byte
 }
 
 var (
@@ -271,3 +279,42 @@ var (
 	_ EthereumSigned = &OutgoingTxBatch{}
 	_ EthereumSigned = &OutgoingLogicCall{}
 )
+
+// NewObservedEventRecord returns the archive record of a claim that was observed at the given Cosmos height with
+// the given voting power, the summary fields are filled in from the claim depending on its type
+func NewObservedEventRecord(claim EthereumClaim, cosmosHeight uint64, observedPower sdk.Int) ObservedEventRecord {
+	record := ObservedEventRecord{
+		EventNonce:     claim.GetEventNonce(),
+		ClaimType:      claim.GetType(),
+		ClaimHash:      claim.ClaimHash(),
+		EthereumHeight: claim.GetBlockHeight(),
+		CosmosHeight:   cosmosHeight,
+		ObservedPower:  observedPower,
+		TokenContract:  "",
+		Amount:         sdk.ZeroInt(),
+		Sender:         "",
+		Receiver:       "",
+		Denom:          "",
+		Nonce:          0,
+	}
+	switch claim := claim.(type) {
+	case *MsgSendToCosmosClaim:
+		record.TokenContract = claim.TokenContract
+		record.Amount = claim.Amount
+		record.Sender = claim.EthereumSender
+		record.Receiver = claim.CosmosReceiver
+	case *MsgBatchSendToEthClaim:
+		record.TokenContract = claim.TokenContract
+		record.Nonce = claim.BatchNonce
+	case *MsgERC20DeployedClaim:
+		record.TokenContract = claim.TokenContract
+		record.Denom = claim.CosmosDenom
+	case *MsgLogicCallExecutedClaim:
+		record.Nonce = claim.InvalidationNonce
+	case *MsgValsetUpdatedClaim:
+		record.TokenContract = claim.RewardToken
+		record.Amount = claim.RewardAmount
+		record.Nonce = claim.ValsetNonce
+	}
+	return record
+}